@@ -0,0 +1,81 @@
+package main
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/db"
+)
+
+var exportSiteCmd = &cobra.Command{
+	Use:   "export-site <output>",
+	Short: "Export the public memos of a user as a static HTML site",
+	Long:  "Export the public memos of a user as a static HTML site, which works on plain static hosting. The site is written to a ZIP if the output ends with .zip, otherwise to a folder.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		username, err := cmd.Flags().GetString("creator")
+		if err != nil {
+			return err
+		}
+		if username == "" {
+			return errors.New("creator is required")
+		}
+		instanceProfile := newInstanceProfile()
+
+		ctx := context.Background()
+		dbDriver, err := db.NewDBDriver(instanceProfile)
+		if err != nil {
+			return errors.Wrap(err, "failed to create db driver")
+		}
+		storeInstance := store.New(dbDriver, instanceProfile)
+		defer storeInstance.Close()
+		if err := storeInstance.Migrate(ctx); err != nil {
+			return errors.Wrap(err, "failed to migrate")
+		}
+		creator, err := storeInstance.GetUser(ctx, &store.FindUser{Username: &username})
+		if err != nil {
+			return errors.Wrap(err, "failed to get creator")
+		}
+		if creator == nil {
+			return errors.Errorf("user %s not found", username)
+		}
+
+		output := args[0]
+		if !strings.EqualFold(filepath.Ext(output), ".zip") {
+			if err := apiv1.ExportStaticSite(ctx, storeInstance, creator, apiv1.NewStaticSiteDirWriter(output)); err != nil {
+				return err
+			}
+			fmt.Printf("Exported the site to %s\n", output)
+			return nil
+		}
+
+		file, err := os.Create(output)
+		if err != nil {
+			return errors.Wrap(err, "failed to create zip file")
+		}
+		defer file.Close()
+		writer := zip.NewWriter(file)
+		if err := apiv1.ExportStaticSite(ctx, storeInstance, creator, apiv1.NewStaticSiteZipWriter(writer)); err != nil {
+			return err
+		}
+		if err := writer.Close(); err != nil {
+			return errors.Wrap(err, "failed to close zip file")
+		}
+		fmt.Printf("Exported the site to %s\n", output)
+		return nil
+	},
+}
+
+func init() {
+	exportSiteCmd.Flags().String("creator", "", "username of the creator of the exported memos")
+	rootCmd.AddCommand(exportSiteCmd)
+}
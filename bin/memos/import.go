@@ -0,0 +1,100 @@
+package main
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/usememos/memos/plugin/importer"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/db"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import <path>",
+	Short: "Import memos from markdown files, an Obsidian vault or an Evernote ENEX file",
+	Long:  "Import memos from a ZIP or a folder of markdown files, e.g. the export of memos, an Obsidian vault, or an Evernote ENEX file.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		username, err := cmd.Flags().GetString("creator")
+		if err != nil {
+			return err
+		}
+		if username == "" {
+			return errors.New("creator is required")
+		}
+		instanceProfile := newInstanceProfile()
+
+		ctx := context.Background()
+		dbDriver, err := db.NewDBDriver(instanceProfile)
+		if err != nil {
+			return errors.Wrap(err, "failed to create db driver")
+		}
+		storeInstance := store.New(dbDriver, instanceProfile)
+		defer storeInstance.Close()
+		if err := storeInstance.Migrate(ctx); err != nil {
+			return errors.Wrap(err, "failed to migrate")
+		}
+		creator, err := storeInstance.GetUser(ctx, &store.FindUser{Username: &username})
+		if err != nil {
+			return errors.Wrap(err, "failed to get creator")
+		}
+		if creator == nil {
+			return errors.Errorf("user %s not found", username)
+		}
+
+		memos, fileErrors, err := parseImportPath(args[0])
+		if err != nil {
+			return err
+		}
+		response, err := apiv1.ImportParsedMemos(ctx, storeInstance, creator.ID, memos, fileErrors)
+		if err != nil {
+			return err
+		}
+		for _, importError := range response.Errors {
+			fmt.Fprintf(os.Stderr, "failed to import %s: %s\n", importError.Path, importError.Message)
+		}
+		fmt.Printf("Imported %d memos, %d failed\n", len(response.Memos), len(response.Errors))
+		return nil
+	},
+}
+
+func init() {
+	importCmd.Flags().String("creator", "", "username of the creator of the imported memos")
+	rootCmd.AddCommand(importCmd)
+}
+
+// parseImportPath parses the ENEX file, the ZIP file or the folder of the path.
+func parseImportPath(path string) ([]*importer.Memo, []*importer.FileError, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if info.IsDir() {
+		memos, fileErrors := importer.Parse(os.DirFS(path))
+		return memos, fileErrors, nil
+	}
+	if strings.EqualFold(filepath.Ext(path), ".enex") {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer file.Close()
+		memos, fileErrors := importer.ParseENEX(filepath.Base(path), file)
+		return memos, fileErrors, nil
+	}
+	reader, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to open zip file")
+	}
+	defer reader.Close()
+	memos, fileErrors := importer.Parse(reader)
+	return memos, fileErrors, nil
+}
@@ -35,19 +35,7 @@ var (
 		Use:   "memos",
 		Short: `An open source, lightweight note-taking service. Easily capture and share your great thoughts.`,
 		Run: func(_ *cobra.Command, _ []string) {
-			instanceProfile := &profile.Profile{
-				Mode:        viper.GetString("mode"),
-				Addr:        viper.GetString("addr"),
-				Port:        viper.GetInt("port"),
-				Data:        viper.GetString("data"),
-				Driver:      viper.GetString("driver"),
-				DSN:         viper.GetString("dsn"),
-				InstanceURL: viper.GetString("instance-url"),
-				Version:     version.GetCurrentVersion(viper.GetString("mode")),
-			}
-			if err := instanceProfile.Validate(); err != nil {
-				panic(err)
-			}
+			instanceProfile := newInstanceProfile()
 
 			ctx, cancel := context.WithCancel(context.Background())
 			dbDriver, err := db.NewDBDriver(instanceProfile)
@@ -140,6 +128,24 @@ func init() {
 	}
 }
 
+// newInstanceProfile creates the validated instance profile from the flags and environment.
+func newInstanceProfile() *profile.Profile {
+	instanceProfile := &profile.Profile{
+		Mode:        viper.GetString("mode"),
+		Addr:        viper.GetString("addr"),
+		Port:        viper.GetInt("port"),
+		Data:        viper.GetString("data"),
+		Driver:      viper.GetString("driver"),
+		DSN:         viper.GetString("dsn"),
+		InstanceURL: viper.GetString("instance-url"),
+		Version:     version.GetCurrentVersion(viper.GetString("mode")),
+	}
+	if err := instanceProfile.Validate(); err != nil {
+		panic(err)
+	}
+	return instanceProfile
+}
+
 func printGreetings(profile *profile.Profile) {
 	if profile.IsDev() {
 		println("Development mode is enabled")
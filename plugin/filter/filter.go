@@ -17,6 +17,17 @@ var MemoFilterCELAttributes = []cel.EnvOption{
 	cel.Variable("visibility", cel.StringType),
 }
 
+// UserFilterCELAttributes are the CEL attributes for user.
+var UserFilterCELAttributes = []cel.EnvOption{
+	cel.Variable("username", cel.StringType),
+	cel.Variable("nickname", cel.StringType),
+	cel.Variable("description", cel.StringType),
+	cel.Variable("location", cel.StringType),
+	cel.Variable("industry", cel.StringType),
+	cel.Variable("occupation", cel.StringType),
+	cel.Variable("university", cel.StringType),
+}
+
 // Parse parses the filter string and returns the parsed expression.
 // The filter string should be a CEL expression.
 func Parse(filter string, opts ...cel.EnvOption) (expr *exprv1.ParsedExpr, err error) {
@@ -0,0 +1,301 @@
+package importer
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// enexTimeLayout is the layout of the times in ENEX, e.g. "20240102T150405Z".
+const enexTimeLayout = "20060102T150405Z"
+
+type enexNote struct {
+	Title     string         `xml:"title"`
+	Content   string         `xml:"content"`
+	Created   string         `xml:"created"`
+	Tags      []string       `xml:"tag"`
+	Resources []enexResource `xml:"resource"`
+}
+
+type enexResource struct {
+	Data       string `xml:"data"`
+	Mime       string `xml:"mime"`
+	Attributes struct {
+		FileName string `xml:"file-name"`
+	} `xml:"resource-attributes"`
+}
+
+// ParseENEX parses the notes of an Evernote ENEX export as memos. The ENML content is converted
+// to markdown with the title as the heading and the tags appended, the resources are attached.
+// The notes are decoded one by one, so a large export is not held in memory as a whole.
+func ParseENEX(filePath string, reader io.Reader) ([]*Memo, []*FileError) {
+	memos, fileErrors := []*Memo{}, []*FileError{}
+	decoder := xml.NewDecoder(reader)
+	// ENEX files may declare an encoding other than UTF-8, which are rare and read as is.
+	decoder.CharsetReader = func(_ string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+	index := 0
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			fileErrors = append(fileErrors, &FileError{Path: filePath, Err: errors.Wrap(err, "invalid enex")})
+			break
+		}
+		element, ok := token.(xml.StartElement)
+		if !ok || element.Name.Local != "note" {
+			continue
+		}
+		index++
+		note := &enexNote{}
+		if err := decoder.DecodeElement(note, &element); err != nil {
+			fileErrors = append(fileErrors, &FileError{Path: fmt.Sprintf("%s#%d", filePath, index), Err: errors.Wrap(err, "invalid note")})
+			break
+		}
+		notePath := fmt.Sprintf("%s#%d", filePath, index)
+		if note.Title != "" {
+			notePath = fmt.Sprintf("%s#%d %s", filePath, index, note.Title)
+		}
+		memo, err := convertENEXNote(note)
+		if err != nil {
+			fileErrors = append(fileErrors, &FileError{Path: notePath, Err: err})
+			continue
+		}
+		memo.Path = notePath
+		memos = append(memos, memo)
+	}
+	return memos, fileErrors
+}
+
+func convertENEXNote(note *enexNote) (*Memo, error) {
+	body, err := convertENMLToMarkdown(note.Content)
+	if err != nil {
+		return nil, err
+	}
+	parts := []string{}
+	if title := strings.TrimSpace(note.Title); title != "" {
+		parts = append(parts, "# "+title)
+	}
+	if body != "" {
+		parts = append(parts, body)
+	}
+	if tags := formatTags(note.Tags); tags != "" {
+		parts = append(parts, tags)
+	}
+	if len(parts) == 0 {
+		return nil, errors.New("empty content")
+	}
+
+	memo := &Memo{
+		Content:     strings.Join(parts, "\n\n"),
+		Attachments: []*Attachment{},
+	}
+	if note.Created != "" {
+		createdTime, err := time.Parse(enexTimeLayout, note.Created)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid created time")
+		}
+		memo.CreatedTs = createdTime.Unix()
+	}
+	for i, resource := range note.Resources {
+		// The base64 data is wrapped in lines.
+		blob, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(resource.Data), ""))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid data of resource %d", i+1)
+		}
+		filename := resource.Attributes.FileName
+		if filename == "" {
+			filename = fmt.Sprintf("attachment-%d", i+1)
+			if extensions, _ := mime.ExtensionsByType(resource.Mime); len(extensions) > 0 {
+				filename += extensions[0]
+			}
+		}
+		attachment := NewAttachment(filename, blob)
+		if resource.Mime != "" {
+			attachment.Type = resource.Mime
+		}
+		memo.Attachments = append(memo.Attachments, attachment)
+	}
+	return memo, nil
+}
+
+var (
+	tagInvalidCharRegexp = regexp.MustCompile(`[\s#]+`)
+	blankLinesRegexp     = regexp.MustCompile(`\n{3,}`)
+	whitespaceRegexp     = regexp.MustCompile(`\s+`)
+)
+
+// formatTags formats the tags as a line of "#tag", the whitespaces in the tags are replaced.
+func formatTags(tags []string) string {
+	formatted := []string{}
+	for _, tag := range tags {
+		tag = tagInvalidCharRegexp.ReplaceAllString(strings.TrimSpace(tag), "-")
+		tag = strings.Trim(tag, "-")
+		if tag != "" {
+			formatted = append(formatted, "#"+tag)
+		}
+	}
+	return strings.Join(formatted, " ")
+}
+
+// convertENMLToMarkdown converts the ENML, the XHTML content of Evernote, to markdown.
+// The media elements are dropped as the resources are attached to the memo.
+func convertENMLToMarkdown(content string) (string, error) {
+	node, err := html.Parse(strings.NewReader(content))
+	if err != nil {
+		return "", errors.Wrap(err, "invalid content")
+	}
+	builder := &strings.Builder{}
+	renderENMLNode(builder, node)
+	markdown := blankLinesRegexp.ReplaceAllString(builder.String(), "\n\n")
+	lines := strings.Split(markdown, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n")), nil
+}
+
+func renderENMLNode(builder *strings.Builder, node *html.Node) {
+	switch node.Type {
+	case html.TextNode:
+		text := whitespaceRegexp.ReplaceAllString(node.Data, " ")
+		if strings.HasSuffix(builder.String(), "\n") || builder.Len() == 0 {
+			text = strings.TrimLeft(text, " ")
+		}
+		builder.WriteString(text)
+		return
+	case html.ElementNode:
+	default:
+		renderENMLChildren(builder, node)
+		return
+	}
+
+	switch node.DataAtom {
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		ensureBlankLine(builder)
+		builder.WriteString(strings.Repeat("#", int(node.Data[1]-'0')) + " ")
+		renderENMLChildren(builder, node)
+		ensureBlankLine(builder)
+	case atom.P, atom.Div, atom.Blockquote, atom.Tr:
+		renderENMLChildren(builder, node)
+		ensureNewline(builder)
+	case atom.Br:
+		builder.WriteString("\n")
+	case atom.Hr:
+		ensureBlankLine(builder)
+		builder.WriteString("---")
+		ensureBlankLine(builder)
+	case atom.Ul, atom.Ol:
+		ensureNewline(builder)
+		renderENMLChildren(builder, node)
+		ensureNewline(builder)
+	case atom.Li:
+		ensureNewline(builder)
+		if node.Parent != nil && node.Parent.DataAtom == atom.Ol {
+			builder.WriteString("1. ")
+		} else {
+			builder.WriteString("- ")
+		}
+		renderENMLChildren(builder, node)
+		ensureNewline(builder)
+	case atom.A:
+		href := getAttribute(node, "href")
+		if href == "" {
+			renderENMLChildren(builder, node)
+			return
+		}
+		builder.WriteString("[")
+		renderENMLChildren(builder, node)
+		builder.WriteString("](" + href + ")")
+	case atom.B, atom.Strong:
+		wrapENMLChildren(builder, node, "**")
+	case atom.I, atom.Em:
+		wrapENMLChildren(builder, node, "*")
+	case atom.S, atom.Strike, atom.Del:
+		wrapENMLChildren(builder, node, "~~")
+	case atom.Code:
+		wrapENMLChildren(builder, node, "`")
+	case atom.Pre:
+		ensureBlankLine(builder)
+		builder.WriteString("```\n" + strings.TrimRight(textContent(node), "\n") + "\n```")
+		ensureBlankLine(builder)
+	case atom.Td, atom.Th:
+		renderENMLChildren(builder, node)
+		builder.WriteString(" ")
+	case atom.Img, atom.Script, atom.Style, atom.Head, atom.Title:
+	default:
+		// The HTML parser doesn't close the self-closing ENML elements,
+		// so their following siblings are parsed as their children.
+		switch node.Data {
+		case "en-todo":
+			if getAttribute(node, "checked") == "true" {
+				builder.WriteString("- [x] ")
+			} else {
+				builder.WriteString("- [ ] ")
+			}
+			renderENMLChildren(builder, node)
+		case "en-crypt":
+		default:
+			renderENMLChildren(builder, node)
+		}
+	}
+}
+
+func renderENMLChildren(builder *strings.Builder, node *html.Node) {
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		renderENMLNode(builder, child)
+	}
+}
+
+func wrapENMLChildren(builder *strings.Builder, node *html.Node, mark string) {
+	inner := &strings.Builder{}
+	renderENMLChildren(inner, node)
+	if text := strings.TrimSpace(inner.String()); text != "" {
+		builder.WriteString(mark + text + mark)
+	}
+}
+
+func ensureNewline(builder *strings.Builder) {
+	if builder.Len() > 0 && !strings.HasSuffix(builder.String(), "\n") {
+		builder.WriteString("\n")
+	}
+}
+
+func ensureBlankLine(builder *strings.Builder) {
+	ensureNewline(builder)
+	if builder.Len() > 0 && !strings.HasSuffix(builder.String(), "\n\n") {
+		builder.WriteString("\n")
+	}
+}
+
+func getAttribute(node *html.Node, key string) string {
+	for _, attribute := range node.Attr {
+		if attribute.Key == key {
+			return attribute.Val
+		}
+	}
+	return ""
+}
+
+func textContent(node *html.Node) string {
+	if node.Type == html.TextNode {
+		return node.Data
+	}
+	builder := &strings.Builder{}
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		builder.WriteString(textContent(child))
+	}
+	return builder.String()
+}
@@ -0,0 +1,52 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testENEX = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE en-export SYSTEM "http://xml.evernote.com/pub/evernote-export4.dtd">
+<en-export export-date="20240102T150405Z" application="Evernote" version="10.0">
+  <note>
+    <title>Shopping list</title>
+    <content><![CDATA[<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<!DOCTYPE en-note SYSTEM "http://xml.evernote.com/pub/enml2.dtd">
+<en-note><div>Buy <b>milk</b> and <a href="https://example.com">bread</a></div><div><en-todo checked="true"/>eggs</div><ul><li>apples</li><li>pears</li></ul><en-media type="image/png" hash="abc"/></en-note>]]></content>
+    <created>20240102T150405Z</created>
+    <tag>home</tag>
+    <tag>to do</tag>
+    <resource>
+      <data encoding="base64">
+aGVs
+bG8=
+      </data>
+      <mime>text/plain</mime>
+      <resource-attributes><file-name>hello.txt</file-name></resource-attributes>
+    </resource>
+  </note>
+  <note>
+    <title>Broken</title>
+    <content><![CDATA[<en-note>text</en-note>]]></content>
+    <created>yesterday</created>
+  </note>
+</en-export>`
+
+func TestParseENEX(t *testing.T) {
+	memos, fileErrors := ParseENEX("notes.enex", strings.NewReader(testENEX))
+	require.Len(t, memos, 1)
+	require.Len(t, fileErrors, 1)
+	require.Equal(t, "notes.enex#2 Broken", fileErrors[0].Path)
+
+	memo := memos[0]
+	require.Equal(t, "notes.enex#1 Shopping list", memo.Path)
+	require.Equal(t, "# Shopping list\n\nBuy **milk** and [bread](https://example.com)\n- [x] eggs\n- apples\n- pears\n\n#home #to-do", memo.Content)
+	require.Equal(t, time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC).Unix(), memo.CreatedTs)
+	require.Len(t, memo.Attachments, 1)
+	require.Equal(t, "hello.txt", memo.Attachments[0].Filename)
+	require.Equal(t, "text/plain", memo.Attachments[0].Type)
+	require.Equal(t, []byte("hello"), memo.Attachments[0].Blob)
+}
@@ -0,0 +1,133 @@
+package importer
+
+import (
+	"io/fs"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// Memo is a memo parsed from an import source.
+type Memo struct {
+	// Path is the path of the source file, used to report errors.
+	Path    string
+	Content string
+	// CreatedTs is the creation time of the memo in unix seconds, zero means now.
+	CreatedTs int64
+	// Visibility is one of "PUBLIC", "PROTECTED", "FOLLOWERS" and "PRIVATE", empty means private.
+	Visibility  string
+	Attachments []*Attachment
+	// Key identifies the memo in the import source, e.g. the path of a note in a vault.
+	Key string
+	// References are the keys of the memos referenced by the memo, e.g. the wikilinks of a note.
+	References []string
+}
+
+// Attachment is a file attached to an imported memo.
+type Attachment struct {
+	Filename string
+	Type     string
+	Blob     []byte
+}
+
+// FileError is an error of a single file, it doesn't abort the whole import.
+type FileError struct {
+	Path string
+	Err  error
+}
+
+func (e *FileError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+// NewAttachment creates an attachment with the type detected from the filename or the content.
+func NewAttachment(filename string, blob []byte) *Attachment {
+	attachmentType := mime.TypeByExtension(path.Ext(filename))
+	if attachmentType == "" {
+		attachmentType = http.DetectContentType(blob)
+	}
+	return &Attachment{
+		Filename: path.Base(filename),
+		Type:     attachmentType,
+		Blob:     blob,
+	}
+}
+
+// Parse parses the memos of the file system by its layout. An Obsidian vault, which has the
+// ".obsidian" folder at the root or in the only top folder, is parsed by ParseObsidian.
+// Otherwise the markdown files are parsed by ParseMarkdown and the ENEX files by ParseENEX.
+func Parse(fsys fs.FS) ([]*Memo, []*FileError) {
+	if vaultRoot, ok := findObsidianVaultRoot(fsys); ok {
+		vault, err := fs.Sub(fsys, vaultRoot)
+		if err != nil {
+			return []*Memo{}, []*FileError{{Path: vaultRoot, Err: err}}
+		}
+		memos, fileErrors := ParseObsidian(vault)
+		if vaultRoot != "." {
+			for _, memo := range memos {
+				memo.Path = path.Join(vaultRoot, memo.Path)
+			}
+			for _, fileError := range fileErrors {
+				fileError.Path = path.Join(vaultRoot, fileError.Path)
+			}
+		}
+		return memos, fileErrors
+	}
+
+	memos, fileErrors := ParseMarkdown(fsys)
+	enexPaths := []string{}
+	if err := fs.WalkDir(fsys, ".", func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			// The walk errors are reported by ParseMarkdown.
+			return nil
+		}
+		if isHiddenPath(filePath) {
+			if entry.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if !entry.IsDir() && strings.EqualFold(path.Ext(filePath), ".enex") {
+			enexPaths = append(enexPaths, filePath)
+		}
+		return nil
+	}); err != nil {
+		fileErrors = append(fileErrors, &FileError{Path: ".", Err: err})
+	}
+	for _, enexPath := range enexPaths {
+		file, err := fsys.Open(enexPath)
+		if err != nil {
+			fileErrors = append(fileErrors, &FileError{Path: enexPath, Err: err})
+			continue
+		}
+		enexMemos, enexErrors := ParseENEX(enexPath, file)
+		file.Close()
+		memos = append(memos, enexMemos...)
+		fileErrors = append(fileErrors, enexErrors...)
+	}
+	return memos, fileErrors
+}
+
+func findObsidianVaultRoot(fsys fs.FS) (string, bool) {
+	if info, err := fs.Stat(fsys, ".obsidian"); err == nil && info.IsDir() {
+		return ".", true
+	}
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return "", false
+	}
+	dirs := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() && !isHiddenPath(entry.Name()) {
+			dirs = append(dirs, entry.Name())
+		}
+	}
+	if len(dirs) != 1 {
+		return "", false
+	}
+	if info, err := fs.Stat(fsys, path.Join(dirs[0], ".obsidian")); err == nil && info.IsDir() {
+		return dirs[0], true
+	}
+	return "", false
+}
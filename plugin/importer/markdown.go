@@ -0,0 +1,204 @@
+package importer
+
+import (
+	"bytes"
+	"io/fs"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// exportFilenameRegexp matches the file names written by ExportMemos,
+	// e.g. "20240102_150405_title_PRIVATE.md".
+	exportFilenameRegexp = regexp.MustCompile(`^(\d{8}_\d{6})_.*_(PUBLIC|PROTECTED|FOLLOWERS|PRIVATE)\.md$`)
+	// markdownLinkRegexp matches the targets of markdown links and images.
+	markdownLinkRegexp = regexp.MustCompile(`!?\[[^\]]*\]\(\s*<?([^)\s>]+)>?(?:\s+"[^"]*")?\s*\)`)
+)
+
+// frontMatterTimeLayouts are the supported layouts of the time in front matter.
+var frontMatterTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// ParseMarkdown parses every markdown file of the file system as a memo, e.g. an extracted
+// ZIP of ExportMemos or a plain folder of markdown files. The creation time and visibility
+// come from the front matter or the exported file name, and the local files linked in the
+// content are attached to the memo.
+func ParseMarkdown(fsys fs.FS) ([]*Memo, []*FileError) {
+	memos, fileErrors := []*Memo{}, []*FileError{}
+	err := fs.WalkDir(fsys, ".", func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			fileErrors = append(fileErrors, &FileError{Path: filePath, Err: err})
+			return nil
+		}
+		if isHiddenPath(filePath) {
+			if entry.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if entry.IsDir() || !strings.EqualFold(path.Ext(filePath), ".md") {
+			return nil
+		}
+		memo, err := parseMarkdownFile(fsys, filePath)
+		if err != nil {
+			fileErrors = append(fileErrors, &FileError{Path: filePath, Err: err})
+			return nil
+		}
+		memos = append(memos, memo)
+		return nil
+	})
+	if err != nil {
+		fileErrors = append(fileErrors, &FileError{Path: ".", Err: err})
+	}
+	return memos, fileErrors
+}
+
+func parseMarkdownFile(fsys fs.FS, filePath string) (*Memo, error) {
+	data, err := fs.ReadFile(fsys, filePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read file")
+	}
+	memo := &Memo{
+		Path: filePath,
+	}
+	if matches := exportFilenameRegexp.FindStringSubmatch(path.Base(filePath)); matches != nil {
+		createdTime, err := time.ParseInLocation("20060102_150405", matches[1], time.Local)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid time in file name")
+		}
+		memo.CreatedTs = createdTime.Unix()
+		memo.Visibility = matches[2]
+	} else if info, err := fs.Stat(fsys, filePath); err == nil && !info.ModTime().IsZero() {
+		memo.CreatedTs = info.ModTime().Unix()
+	}
+
+	content, frontMatter, err := splitFrontMatter(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyFrontMatter(memo, frontMatter); err != nil {
+		return nil, err
+	}
+	memo.Content = strings.TrimSpace(content)
+	if memo.Content == "" {
+		return nil, errors.New("empty content")
+	}
+
+	attachments, err := readLinkedAttachments(fsys, path.Dir(filePath), memo.Content)
+	if err != nil {
+		return nil, err
+	}
+	memo.Attachments = attachments
+	return memo, nil
+}
+
+// splitFrontMatter splits the leading YAML front matter delimited by "---" from the content.
+func splitFrontMatter(data []byte) (string, map[string]any, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	content := strings.ReplaceAll(string(data), "\r\n", "\n")
+	if !strings.HasPrefix(content, "---\n") {
+		return content, nil, nil
+	}
+	end := strings.Index(content[4:], "\n---")
+	if end < 0 {
+		return content, nil, nil
+	}
+	frontMatter := map[string]any{}
+	if err := yaml.Unmarshal([]byte(content[4:4+end]), &frontMatter); err != nil {
+		return "", nil, errors.Wrap(err, "invalid front matter")
+	}
+	rest := content[4+end+len("\n---"):]
+	return strings.TrimPrefix(rest, "\n"), frontMatter, nil
+}
+
+func applyFrontMatter(memo *Memo, frontMatter map[string]any) error {
+	for _, key := range []string{"created", "created_at", "create_time", "date"} {
+		value, ok := frontMatter[key]
+		if !ok {
+			continue
+		}
+		createdTs, err := parseFrontMatterTime(value)
+		if err != nil {
+			return errors.Wrapf(err, "invalid %s in front matter", key)
+		}
+		memo.CreatedTs = createdTs
+		break
+	}
+	if value, ok := frontMatter["visibility"]; ok {
+		visibility := strings.ToUpper(strings.TrimSpace(stringValue(value)))
+		if !slices.Contains([]string{"PUBLIC", "PROTECTED", "FOLLOWERS", "PRIVATE"}, visibility) {
+			return errors.Errorf("invalid visibility %q in front matter", stringValue(value))
+		}
+		memo.Visibility = visibility
+	}
+	return nil
+}
+
+func parseFrontMatterTime(value any) (int64, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v.Unix(), nil
+	case int:
+		return int64(v), nil
+	case string:
+		for _, layout := range frontMatterTimeLayouts {
+			if t, err := time.ParseInLocation(layout, strings.TrimSpace(v), time.Local); err == nil {
+				return t.Unix(), nil
+			}
+		}
+	}
+	return 0, errors.Errorf("unsupported time %v", value)
+}
+
+// readLinkedAttachments reads the local files linked in the content, the paths are relative to dir.
+func readLinkedAttachments(fsys fs.FS, dir, content string) ([]*Attachment, error) {
+	attachments := []*Attachment{}
+	seen := map[string]bool{}
+	for _, matches := range markdownLinkRegexp.FindAllStringSubmatch(content, -1) {
+		target, err := url.PathUnescape(matches[1])
+		if err != nil || strings.Contains(target, ":") || strings.HasPrefix(target, "#") || strings.HasPrefix(target, "/") {
+			continue
+		}
+		attachmentPath := path.Join(dir, target)
+		if seen[attachmentPath] || !fs.ValidPath(attachmentPath) || strings.EqualFold(path.Ext(attachmentPath), ".md") {
+			continue
+		}
+		seen[attachmentPath] = true
+		blob, err := fs.ReadFile(fsys, attachmentPath)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read attachment %s", attachmentPath)
+		}
+		attachments = append(attachments, NewAttachment(attachmentPath, blob))
+	}
+	return attachments, nil
+}
+
+func isHiddenPath(filePath string) bool {
+	for _, part := range strings.Split(filePath, "/") {
+		if part == "__MACOSX" || (len(part) > 1 && strings.HasPrefix(part, ".")) {
+			return true
+		}
+	}
+	return false
+}
+
+func stringValue(value any) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return ""
+}
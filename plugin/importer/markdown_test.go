@@ -0,0 +1,53 @@
+package importer
+
+import (
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMarkdown(t *testing.T) {
+	fsys := fstest.MapFS{
+		"2024/01/20240102_150405_Hello_world_PROTECTED.md": {Data: []byte("Hello world\n\n![image](assets/image.png)")},
+		"2024/01/assets/image.png":                         {Data: []byte("\x89PNG\r\n\x1a\n")},
+		"2024/02/20240203_101112_Followers_FOLLOWERS.md":   {Data: []byte("For followers")},
+		"notes/front.md":                                   {Data: []byte("---\ncreated: 2023-05-06 07:08:09\nvisibility: public\n---\n#tag content")},
+		"notes/followers.md":                               {Data: []byte("---\nvisibility: followers\n---\nfollowers content")},
+		"notes/invalid.md":                                 {Data: []byte("---\nvisibility: secret\n---\ncontent")},
+		"notes/empty.md":                                   {Data: []byte("  \n")},
+		"notes/readme.txt":                                 {Data: []byte("not a memo")},
+		".obsidian/hidden.md":                              {Data: []byte("hidden")},
+	}
+
+	memos, fileErrors := ParseMarkdown(fsys)
+	require.Len(t, memos, 4)
+	require.Len(t, fileErrors, 2)
+
+	exported := memos[0]
+	createdTime, err := time.ParseInLocation("20060102_150405", "20240102_150405", time.Local)
+	require.NoError(t, err)
+	require.Equal(t, "2024/01/20240102_150405_Hello_world_PROTECTED.md", exported.Path)
+	require.Equal(t, createdTime.Unix(), exported.CreatedTs)
+	require.Equal(t, "PROTECTED", exported.Visibility)
+	require.Len(t, exported.Attachments, 1)
+	require.Equal(t, "image.png", exported.Attachments[0].Filename)
+	require.Equal(t, "image/png", exported.Attachments[0].Type)
+
+	require.Equal(t, "2024/02/20240203_101112_Followers_FOLLOWERS.md", memos[1].Path)
+	require.Equal(t, "FOLLOWERS", memos[1].Visibility)
+	require.Equal(t, "notes/followers.md", memos[2].Path)
+	require.Equal(t, "FOLLOWERS", memos[2].Visibility)
+
+	frontMatter := memos[3]
+	createdTime, err = time.ParseInLocation("2006-01-02 15:04:05", "2023-05-06 07:08:09", time.Local)
+	require.NoError(t, err)
+	require.Equal(t, "#tag content", frontMatter.Content)
+	require.Equal(t, createdTime.Unix(), frontMatter.CreatedTs)
+	require.Equal(t, "PUBLIC", frontMatter.Visibility)
+	require.Empty(t, frontMatter.Attachments)
+
+	require.Equal(t, "notes/empty.md", fileErrors[0].Path)
+	require.Equal(t, "notes/invalid.md", fileErrors[1].Path)
+}
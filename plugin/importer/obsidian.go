@@ -0,0 +1,200 @@
+package importer
+
+import (
+	"io/fs"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// wikilinkRegexp matches the wikilinks and embeds of Obsidian, e.g. "[[note#heading|alias]]" and "![[image.png]]".
+var wikilinkRegexp = regexp.MustCompile(`(!?)\[\[([^\[\]|#^]*)(?:[#^][^\[\]|]*)?(?:\|([^\[\]]*))?\]\]`)
+
+// obsidianNote is a note of a vault, its links are resolved after all notes are read.
+type obsidianNote struct {
+	memo *Memo
+	dir  string
+}
+
+// ParseObsidian parses the notes of an Obsidian vault as memos. The front matter sets the creation
+// time, visibility and tags, the "![[embeds]]" of files become attachments, and the "[[wikilinks]]"
+// and embeds of notes become references to the linked memos by their keys.
+func ParseObsidian(fsys fs.FS) ([]*Memo, []*FileError) {
+	notes, fileErrors := []*obsidianNote{}, []*FileError{}
+	// notePaths and attachmentPaths map the lower-cased paths and names to the file paths.
+	notePaths, attachmentPaths := map[string]string{}, map[string]string{}
+	err := fs.WalkDir(fsys, ".", func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			fileErrors = append(fileErrors, &FileError{Path: filePath, Err: err})
+			return nil
+		}
+		if isHiddenPath(filePath) {
+			if entry.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		if !strings.EqualFold(path.Ext(filePath), ".md") {
+			addLinkTarget(attachmentPaths, filePath, filePath)
+			return nil
+		}
+		memo, aliases, err := parseObsidianNote(fsys, filePath)
+		if err != nil {
+			fileErrors = append(fileErrors, &FileError{Path: filePath, Err: err})
+			return nil
+		}
+		notes = append(notes, &obsidianNote{memo: memo, dir: path.Dir(filePath)})
+		addLinkTarget(notePaths, strings.TrimSuffix(filePath, path.Ext(filePath)), filePath)
+		for _, alias := range aliases {
+			if _, ok := notePaths[strings.ToLower(alias)]; !ok {
+				notePaths[strings.ToLower(alias)] = filePath
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		fileErrors = append(fileErrors, &FileError{Path: ".", Err: err})
+	}
+
+	memos := []*Memo{}
+	for _, note := range notes {
+		memo := note.memo
+		seenAttachments, seenReferences := map[string]bool{}, map[string]bool{}
+		var readErr error
+		memo.Content = wikilinkRegexp.ReplaceAllStringFunc(memo.Content, func(link string) string {
+			matches := wikilinkRegexp.FindStringSubmatch(link)
+			embed, target, alias := matches[1] == "!", strings.TrimSpace(matches[2]), strings.TrimSpace(matches[3])
+			text := alias
+			if text == "" {
+				text = path.Base(target)
+			}
+			if notePath, ok := resolveLinkTarget(notePaths, note.dir, strings.TrimSuffix(target, ".md")); ok {
+				if notePath != memo.Key && !seenReferences[notePath] {
+					seenReferences[notePath] = true
+					memo.References = append(memo.References, notePath)
+				}
+				if embed {
+					return ""
+				}
+				return text
+			}
+			if attachmentPath, ok := resolveLinkTarget(attachmentPaths, note.dir, target); ok && embed {
+				if !seenAttachments[attachmentPath] {
+					seenAttachments[attachmentPath] = true
+					blob, err := fs.ReadFile(fsys, attachmentPath)
+					if err != nil {
+						readErr = errors.Wrapf(err, "failed to read attachment %s", attachmentPath)
+						return link
+					}
+					memo.Attachments = append(memo.Attachments, NewAttachment(attachmentPath, blob))
+				}
+				return ""
+			}
+			return text
+		})
+		if readErr != nil {
+			fileErrors = append(fileErrors, &FileError{Path: memo.Path, Err: readErr})
+			continue
+		}
+		memo.Content = strings.TrimSpace(blankLinesRegexp.ReplaceAllString(memo.Content, "\n\n"))
+
+		// The markdown links to local files are attached as well.
+		attachments, err := readLinkedAttachments(fsys, note.dir, memo.Content)
+		if err != nil {
+			fileErrors = append(fileErrors, &FileError{Path: memo.Path, Err: err})
+			continue
+		}
+		memo.Attachments = append(memo.Attachments, attachments...)
+		if memo.Content == "" && len(memo.Attachments) == 0 {
+			fileErrors = append(fileErrors, &FileError{Path: memo.Path, Err: errors.New("empty content")})
+			continue
+		}
+		memos = append(memos, memo)
+	}
+	return memos, fileErrors
+}
+
+// parseObsidianNote reads the note with its front matter, it returns the aliases of the note.
+func parseObsidianNote(fsys fs.FS, filePath string) (*Memo, []string, error) {
+	data, err := fs.ReadFile(fsys, filePath)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to read file")
+	}
+	memo := &Memo{
+		Path:        filePath,
+		Key:         filePath,
+		Attachments: []*Attachment{},
+		References:  []string{},
+	}
+	if info, err := fs.Stat(fsys, filePath); err == nil && !info.ModTime().IsZero() {
+		memo.CreatedTs = info.ModTime().Unix()
+	}
+	content, frontMatter, err := splitFrontMatter(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := applyFrontMatter(memo, frontMatter); err != nil {
+		return nil, nil, err
+	}
+	memo.Content = strings.TrimSpace(content)
+	tags := append(stringListValue(frontMatter["tags"]), stringListValue(frontMatter["tag"])...)
+	if formatted := formatTags(tags); formatted != "" {
+		memo.Content = strings.TrimSpace(memo.Content + "\n\n" + formatted)
+	}
+	aliases := []string{}
+	for _, key := range []string{"aliases", "alias"} {
+		// A single alias may contain spaces.
+		if alias, ok := frontMatter[key].(string); ok {
+			aliases = append(aliases, strings.TrimSpace(alias))
+		} else {
+			aliases = append(aliases, stringListValue(frontMatter[key])...)
+		}
+	}
+	return memo, aliases, nil
+}
+
+// addLinkTarget registers the file by its lower-cased path and name, the first file wins a name.
+func addLinkTarget(targets map[string]string, name, filePath string) {
+	targets[strings.ToLower(name)] = filePath
+	if base := strings.ToLower(path.Base(name)); base != strings.ToLower(name) {
+		if _, ok := targets[base]; !ok {
+			targets[base] = filePath
+		}
+	}
+}
+
+// resolveLinkTarget resolves the link target like Obsidian: relative to the note, from the vault root, then by name.
+func resolveLinkTarget(targets map[string]string, dir, target string) (string, bool) {
+	if target == "" {
+		return "", false
+	}
+	for _, candidate := range []string{path.Join(dir, target), path.Clean(target), path.Base(target)} {
+		if filePath, ok := targets[strings.ToLower(candidate)]; ok {
+			return filePath, true
+		}
+	}
+	return "", false
+}
+
+// stringListValue returns the strings of a YAML list, or the words of a string separated by commas or spaces.
+func stringListValue(value any) []string {
+	values := []string{}
+	switch v := value.(type) {
+	case string:
+		values = append(values, strings.FieldsFunc(v, func(r rune) bool {
+			return r == ',' || r == ' '
+		})...)
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, strings.TrimSpace(s))
+			}
+		}
+	}
+	return values
+}
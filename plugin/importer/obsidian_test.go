@@ -0,0 +1,44 @@
+package importer
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseObsidian(t *testing.T) {
+	fsys := fstest.MapFS{
+		"Vault/.obsidian/app.json":       {Data: []byte("{}")},
+		"Vault/Daily.md":                 {Data: []byte("---\ntags: [journal, work/meeting]\nvisibility: protected\n---\nMet with [[Projects/Memos|the team]] about [[Roadmap]].\n\n![[diagram.png]]")},
+		"Vault/Projects/Memos.md":        {Data: []byte("---\naliases: Roadmap\n---\nThe #memos project, see [[Daily#Notes]] and [[Missing note]].")},
+		"Vault/attachments/diagram.png":  {Data: []byte("\x89PNG\r\n\x1a\n")},
+		"Vault/Empty.md":                 {Data: []byte("![[Missing.png]]")},
+		"Vault/.trash/Deleted.md":        {Data: []byte("deleted")},
+		"Vault/attachments/unused.pdf":   {Data: []byte("%PDF")},
+		"Vault/Projects/Nested/Other.md": {Data: []byte("[[Memos]]")},
+	}
+
+	memos, fileErrors := Parse(fsys)
+	require.Len(t, memos, 4)
+	require.Empty(t, fileErrors)
+	memoMap := map[string]*Memo{}
+	for _, memo := range memos {
+		memoMap[memo.Path] = memo
+	}
+
+	daily := memoMap["Vault/Daily.md"]
+	require.Equal(t, "Daily.md", daily.Key)
+	require.Equal(t, "Met with the team about Roadmap.\n\n#journal #work/meeting", daily.Content)
+	require.Equal(t, "PROTECTED", daily.Visibility)
+	require.Equal(t, []string{"Projects/Memos.md"}, daily.References)
+	require.Len(t, daily.Attachments, 1)
+	require.Equal(t, "diagram.png", daily.Attachments[0].Filename)
+
+	project := memoMap["Vault/Projects/Memos.md"]
+	require.Equal(t, "The #memos project, see Daily and Missing note.", project.Content)
+	require.Equal(t, []string{"Daily.md"}, project.References)
+
+	require.Equal(t, "Missing.png", memoMap["Vault/Empty.md"].Content)
+	require.Equal(t, []string{"Projects/Memos.md"}, memoMap["Vault/Projects/Nested/Other.md"].References)
+}
@@ -0,0 +1,98 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Tokenizer splits a search query into the terms matched against memo content.
+type Tokenizer interface {
+	Tokenize(text string) []string
+}
+
+// DefaultTokenizer is the tokenizer used by the memo search path.
+var DefaultTokenizer Tokenizer = NGramTokenizer{N: 2}
+
+// Tokenize splits the text with the default tokenizer.
+func Tokenize(text string) []string {
+	return DefaultTokenizer.Tokenize(text)
+}
+
+// WhitespaceTokenizer splits text by whitespace.
+type WhitespaceTokenizer struct{}
+
+func (WhitespaceTokenizer) Tokenize(text string) []string {
+	return uniqueTokens(strings.Fields(text))
+}
+
+// NGramTokenizer splits runs of CJK characters into overlapping n-grams,
+// as CJK text has no word boundaries. Other text is split by whitespace.
+type NGramTokenizer struct {
+	N int
+}
+
+func (t NGramTokenizer) Tokenize(text string) []string {
+	n := t.N
+	if n <= 0 {
+		n = 2
+	}
+
+	tokens := []string{}
+	for _, field := range strings.Fields(text) {
+		for _, segment := range splitCJKSegments(field) {
+			runes := []rune(segment)
+			if !isCJK(runes[0]) || len(runes) <= n {
+				tokens = append(tokens, segment)
+				continue
+			}
+			for i := 0; i+n <= len(runes); i++ {
+				tokens = append(tokens, string(runes[i:i+n]))
+			}
+		}
+	}
+	return uniqueTokens(tokens)
+}
+
+// splitCJKSegments splits the text into runs of CJK and non-CJK characters.
+func splitCJKSegments(text string) []string {
+	segments := []string{}
+	var builder strings.Builder
+	var inCJK bool
+	for _, r := range text {
+		if builder.Len() > 0 && isCJK(r) != inCJK {
+			segments = append(segments, builder.String())
+			builder.Reset()
+		}
+		inCJK = isCJK(r)
+		builder.WriteRune(r)
+	}
+	if builder.Len() > 0 {
+		segments = append(segments, builder.String())
+	}
+	return segments
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+func uniqueTokens(tokens []string) []string {
+	seen := map[string]bool{}
+	list := []string{}
+	for _, token := range tokens {
+		if seen[token] {
+			continue
+		}
+		seen[token] = true
+		list = append(list, token)
+	}
+	return list
+}
+
+// likeEscaper escapes the wildcards of the LIKE patterns, and the escape character itself.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// EscapeLike escapes the term to be matched literally by a LIKE pattern with ESCAPE '\'.
+func EscapeLike(term string) string {
+	return likeEscaper.Replace(term)
+}
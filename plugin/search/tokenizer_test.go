@@ -0,0 +1,49 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNGramTokenizer(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{
+			text: "hello world",
+			want: []string{"hello", "world"},
+		},
+		{
+			text: "中文",
+			want: []string{"中文"},
+		},
+		{
+			text: "今天天气很好",
+			want: []string{"今天", "天天", "天气", "气很", "很好"},
+		},
+		{
+			text: "memos是笔记应用",
+			want: []string{"memos", "是笔", "笔记", "记应", "应用"},
+		},
+		{
+			text: "  ",
+			want: []string{},
+		},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, NGramTokenizer{N: 2}.Tokenize(test.text))
+	}
+}
+
+func TestWhitespaceTokenizer(t *testing.T) {
+	require.Equal(t, []string{"今天天气", "hello"}, WhitespaceTokenizer{}.Tokenize("今天天气 hello hello"))
+}
+
+func TestEscapeLike(t *testing.T) {
+	require.Equal(t, `100\%`, EscapeLike("100%"))
+	require.Equal(t, `a\_b`, EscapeLike("a_b"))
+	require.Equal(t, `c\\d`, EscapeLike(`c\d`))
+	require.Equal(t, "hello", EscapeLike("hello"))
+}
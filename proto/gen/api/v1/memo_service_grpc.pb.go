@@ -8,6 +8,7 @@ package apiv1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -20,23 +21,37 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MemoService_CreateMemo_FullMethodName         = "/memos.api.v1.MemoService/CreateMemo"
-	MemoService_ListMemos_FullMethodName          = "/memos.api.v1.MemoService/ListMemos"
-	MemoService_GetMemo_FullMethodName            = "/memos.api.v1.MemoService/GetMemo"
-	MemoService_UpdateMemo_FullMethodName         = "/memos.api.v1.MemoService/UpdateMemo"
-	MemoService_DeleteMemo_FullMethodName         = "/memos.api.v1.MemoService/DeleteMemo"
-	MemoService_ExportMemos_FullMethodName        = "/memos.api.v1.MemoService/ExportMemos"
-	MemoService_RenameMemoTag_FullMethodName      = "/memos.api.v1.MemoService/RenameMemoTag"
-	MemoService_DeleteMemoTag_FullMethodName      = "/memos.api.v1.MemoService/DeleteMemoTag"
-	MemoService_SetMemoResources_FullMethodName   = "/memos.api.v1.MemoService/SetMemoResources"
-	MemoService_ListMemoResources_FullMethodName  = "/memos.api.v1.MemoService/ListMemoResources"
-	MemoService_SetMemoRelations_FullMethodName   = "/memos.api.v1.MemoService/SetMemoRelations"
-	MemoService_ListMemoRelations_FullMethodName  = "/memos.api.v1.MemoService/ListMemoRelations"
-	MemoService_CreateMemoComment_FullMethodName  = "/memos.api.v1.MemoService/CreateMemoComment"
-	MemoService_ListMemoComments_FullMethodName   = "/memos.api.v1.MemoService/ListMemoComments"
-	MemoService_ListMemoReactions_FullMethodName  = "/memos.api.v1.MemoService/ListMemoReactions"
-	MemoService_UpsertMemoReaction_FullMethodName = "/memos.api.v1.MemoService/UpsertMemoReaction"
-	MemoService_DeleteMemoReaction_FullMethodName = "/memos.api.v1.MemoService/DeleteMemoReaction"
+	MemoService_CreateMemo_FullMethodName          = "/memos.api.v1.MemoService/CreateMemo"
+	MemoService_ListMemos_FullMethodName           = "/memos.api.v1.MemoService/ListMemos"
+	MemoService_SearchMemos_FullMethodName         = "/memos.api.v1.MemoService/SearchMemos"
+	MemoService_GetMemo_FullMethodName             = "/memos.api.v1.MemoService/GetMemo"
+	MemoService_UpdateMemo_FullMethodName          = "/memos.api.v1.MemoService/UpdateMemo"
+	MemoService_DeleteMemo_FullMethodName          = "/memos.api.v1.MemoService/DeleteMemo"
+	MemoService_ListTrashedMemos_FullMethodName    = "/memos.api.v1.MemoService/ListTrashedMemos"
+	MemoService_RestoreMemo_FullMethodName         = "/memos.api.v1.MemoService/RestoreMemo"
+	MemoService_PurgeMemo_FullMethodName           = "/memos.api.v1.MemoService/PurgeMemo"
+	MemoService_ExportMemos_FullMethodName         = "/memos.api.v1.MemoService/ExportMemos"
+	MemoService_ExportMemoArchive_FullMethodName   = "/memos.api.v1.MemoService/ExportMemoArchive"
+	MemoService_ExportMemoSite_FullMethodName      = "/memos.api.v1.MemoService/ExportMemoSite"
+	MemoService_ImportMemos_FullMethodName         = "/memos.api.v1.MemoService/ImportMemos"
+	MemoService_RenameMemoTag_FullMethodName       = "/memos.api.v1.MemoService/RenameMemoTag"
+	MemoService_DeleteMemoTag_FullMethodName       = "/memos.api.v1.MemoService/DeleteMemoTag"
+	MemoService_SetMemoResources_FullMethodName    = "/memos.api.v1.MemoService/SetMemoResources"
+	MemoService_ListMemoResources_FullMethodName   = "/memos.api.v1.MemoService/ListMemoResources"
+	MemoService_SetMemoRelations_FullMethodName    = "/memos.api.v1.MemoService/SetMemoRelations"
+	MemoService_ListMemoRelations_FullMethodName   = "/memos.api.v1.MemoService/ListMemoRelations"
+	MemoService_CreateMemoComment_FullMethodName   = "/memos.api.v1.MemoService/CreateMemoComment"
+	MemoService_ListMemoComments_FullMethodName    = "/memos.api.v1.MemoService/ListMemoComments"
+	MemoService_ListMemoReactions_FullMethodName   = "/memos.api.v1.MemoService/ListMemoReactions"
+	MemoService_UpsertMemoReaction_FullMethodName  = "/memos.api.v1.MemoService/UpsertMemoReaction"
+	MemoService_DeleteMemoReaction_FullMethodName  = "/memos.api.v1.MemoService/DeleteMemoReaction"
+	MemoService_ListMemoRevisions_FullMethodName   = "/memos.api.v1.MemoService/ListMemoRevisions"
+	MemoService_GetMemoRevision_FullMethodName     = "/memos.api.v1.MemoService/GetMemoRevision"
+	MemoService_RestoreMemoRevision_FullMethodName = "/memos.api.v1.MemoService/RestoreMemoRevision"
+	MemoService_CreateMemoShare_FullMethodName     = "/memos.api.v1.MemoService/CreateMemoShare"
+	MemoService_ListMemoShares_FullMethodName      = "/memos.api.v1.MemoService/ListMemoShares"
+	MemoService_RevokeMemoShare_FullMethodName     = "/memos.api.v1.MemoService/RevokeMemoShare"
+	MemoService_SyncMemos_FullMethodName           = "/memos.api.v1.MemoService/SyncMemos"
 )
 
 // MemoServiceClient is the client API for MemoService service.
@@ -44,17 +59,34 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MemoServiceClient interface {
 	// CreateMemo creates a memo.
+	// The retries with the same Idempotency-Key header or metadata return the memo created first.
 	CreateMemo(ctx context.Context, in *CreateMemoRequest, opts ...grpc.CallOption) (*Memo, error)
 	// ListMemos lists memos with pagination and filter.
 	ListMemos(ctx context.Context, in *ListMemosRequest, opts ...grpc.CallOption) (*ListMemosResponse, error)
+	// SearchMemos searches memos by full-text query, ordered by relevance.
+	SearchMemos(ctx context.Context, in *SearchMemosRequest, opts ...grpc.CallOption) (*SearchMemosResponse, error)
 	// GetMemo gets a memo.
 	GetMemo(ctx context.Context, in *GetMemoRequest, opts ...grpc.CallOption) (*Memo, error)
 	// UpdateMemo updates a memo.
 	UpdateMemo(ctx context.Context, in *UpdateMemoRequest, opts ...grpc.CallOption) (*Memo, error)
-	// DeleteMemo deletes a memo.
+	// DeleteMemo moves a memo to the trash.
 	DeleteMemo(ctx context.Context, in *DeleteMemoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListTrashedMemos lists the memos in the trash of the current user.
+	ListTrashedMemos(ctx context.Context, in *ListTrashedMemosRequest, opts ...grpc.CallOption) (*ListTrashedMemosResponse, error)
+	// RestoreMemo restores a memo from the trash.
+	RestoreMemo(ctx context.Context, in *RestoreMemoRequest, opts ...grpc.CallOption) (*Memo, error)
+	// PurgeMemo permanently deletes a memo in the trash.
+	PurgeMemo(ctx context.Context, in *PurgeMemoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ExportMemos exports memos.
 	ExportMemos(ctx context.Context, in *ExportMemosRequest, opts ...grpc.CallOption) (*ExportMemosResponse, error)
+	// ExportMemoArchive streams a ZIP archive of the memos of the current user with
+	// their comments, resources and metadata.
+	ExportMemoArchive(ctx context.Context, in *ExportMemoArchiveRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error)
+	// ExportMemoSite streams a ZIP of a static HTML site of the public memos of a user.
+	ExportMemoSite(ctx context.Context, in *ExportMemoSiteRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error)
+	// ImportMemos imports memos from a ZIP of markdown files, e.g. the content of ExportMemos,
+	// a ZIP of an Obsidian vault or an Evernote ENEX file.
+	ImportMemos(ctx context.Context, in *ImportMemosRequest, opts ...grpc.CallOption) (*ImportMemosResponse, error)
 	// RenameMemoTag renames a tag for a memo.
 	RenameMemoTag(ctx context.Context, in *RenameMemoTagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// DeleteMemoTag deletes a tag for a memo.
@@ -77,6 +109,21 @@ type MemoServiceClient interface {
 	UpsertMemoReaction(ctx context.Context, in *UpsertMemoReactionRequest, opts ...grpc.CallOption) (*Reaction, error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(ctx context.Context, in *DeleteMemoReactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListMemoRevisions lists revisions for a memo.
+	ListMemoRevisions(ctx context.Context, in *ListMemoRevisionsRequest, opts ...grpc.CallOption) (*ListMemoRevisionsResponse, error)
+	// GetMemoRevision gets a revision of a memo.
+	GetMemoRevision(ctx context.Context, in *GetMemoRevisionRequest, opts ...grpc.CallOption) (*MemoRevision, error)
+	// RestoreMemoRevision restores a memo to the given revision.
+	RestoreMemoRevision(ctx context.Context, in *RestoreMemoRevisionRequest, opts ...grpc.CallOption) (*Memo, error)
+	// CreateMemoShare creates a link sharing the memo by a secret token.
+	CreateMemoShare(ctx context.Context, in *CreateMemoShareRequest, opts ...grpc.CallOption) (*MemoShare, error)
+	// ListMemoShares lists the share links of a memo.
+	ListMemoShares(ctx context.Context, in *ListMemoSharesRequest, opts ...grpc.CallOption) (*ListMemoSharesResponse, error)
+	// RevokeMemoShare revokes a share link of a memo.
+	RevokeMemoShare(ctx context.Context, in *RevokeMemoShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SyncMemos returns the memos and resources of the current user changed since the sync token,
+	// along with the tombstones of the deleted and archived ones.
+	SyncMemos(ctx context.Context, in *SyncMemosRequest, opts ...grpc.CallOption) (*SyncMemosResponse, error)
 }
 
 type memoServiceClient struct {
@@ -107,6 +154,16 @@ func (c *memoServiceClient) ListMemos(ctx context.Context, in *ListMemosRequest,
 	return out, nil
 }
 
+func (c *memoServiceClient) SearchMemos(ctx context.Context, in *SearchMemosRequest, opts ...grpc.CallOption) (*SearchMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_SearchMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) GetMemo(ctx context.Context, in *GetMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Memo)
@@ -137,6 +194,36 @@ func (c *memoServiceClient) DeleteMemo(ctx context.Context, in *DeleteMemoReques
 	return out, nil
 }
 
+func (c *memoServiceClient) ListTrashedMemos(ctx context.Context, in *ListTrashedMemosRequest, opts ...grpc.CallOption) (*ListTrashedMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashedMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListTrashedMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) RestoreMemo(ctx context.Context, in *RestoreMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_RestoreMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) PurgeMemo(ctx context.Context, in *PurgeMemoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemoService_PurgeMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) ExportMemos(ctx context.Context, in *ExportMemosRequest, opts ...grpc.CallOption) (*ExportMemosResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExportMemosResponse)
@@ -147,6 +234,54 @@ func (c *memoServiceClient) ExportMemos(ctx context.Context, in *ExportMemosRequ
 	return out, nil
 }
 
+func (c *memoServiceClient) ExportMemoArchive(ctx context.Context, in *ExportMemoArchiveRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MemoService_ServiceDesc.Streams[0], MemoService_ExportMemoArchive_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportMemoArchiveRequest, httpbody.HttpBody]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MemoService_ExportMemoArchiveClient = grpc.ServerStreamingClient[httpbody.HttpBody]
+
+func (c *memoServiceClient) ExportMemoSite(ctx context.Context, in *ExportMemoSiteRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MemoService_ServiceDesc.Streams[1], MemoService_ExportMemoSite_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportMemoSiteRequest, httpbody.HttpBody]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MemoService_ExportMemoSiteClient = grpc.ServerStreamingClient[httpbody.HttpBody]
+
+func (c *memoServiceClient) ImportMemos(ctx context.Context, in *ImportMemosRequest, opts ...grpc.CallOption) (*ImportMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_ImportMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) RenameMemoTag(ctx context.Context, in *RenameMemoTagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -257,22 +392,109 @@ func (c *memoServiceClient) DeleteMemoReaction(ctx context.Context, in *DeleteMe
 	return out, nil
 }
 
+func (c *memoServiceClient) ListMemoRevisions(ctx context.Context, in *ListMemoRevisionsRequest, opts ...grpc.CallOption) (*ListMemoRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoRevisionsResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetMemoRevision(ctx context.Context, in *GetMemoRevisionRequest, opts ...grpc.CallOption) (*MemoRevision, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoRevision)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) RestoreMemoRevision(ctx context.Context, in *RestoreMemoRevisionRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_RestoreMemoRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) CreateMemoShare(ctx context.Context, in *CreateMemoShareRequest, opts ...grpc.CallOption) (*MemoShare, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoShare)
+	err := c.cc.Invoke(ctx, MemoService_CreateMemoShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListMemoShares(ctx context.Context, in *ListMemoSharesRequest, opts ...grpc.CallOption) (*ListMemoSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoSharesResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) RevokeMemoShare(ctx context.Context, in *RevokeMemoShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemoService_RevokeMemoShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) SyncMemos(ctx context.Context, in *SyncMemosRequest, opts ...grpc.CallOption) (*SyncMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_SyncMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MemoServiceServer is the server API for MemoService service.
 // All implementations must embed UnimplementedMemoServiceServer
 // for forward compatibility.
 type MemoServiceServer interface {
 	// CreateMemo creates a memo.
+	// The retries with the same Idempotency-Key header or metadata return the memo created first.
 	CreateMemo(context.Context, *CreateMemoRequest) (*Memo, error)
 	// ListMemos lists memos with pagination and filter.
 	ListMemos(context.Context, *ListMemosRequest) (*ListMemosResponse, error)
+	// SearchMemos searches memos by full-text query, ordered by relevance.
+	SearchMemos(context.Context, *SearchMemosRequest) (*SearchMemosResponse, error)
 	// GetMemo gets a memo.
 	GetMemo(context.Context, *GetMemoRequest) (*Memo, error)
 	// UpdateMemo updates a memo.
 	UpdateMemo(context.Context, *UpdateMemoRequest) (*Memo, error)
-	// DeleteMemo deletes a memo.
+	// DeleteMemo moves a memo to the trash.
 	DeleteMemo(context.Context, *DeleteMemoRequest) (*emptypb.Empty, error)
+	// ListTrashedMemos lists the memos in the trash of the current user.
+	ListTrashedMemos(context.Context, *ListTrashedMemosRequest) (*ListTrashedMemosResponse, error)
+	// RestoreMemo restores a memo from the trash.
+	RestoreMemo(context.Context, *RestoreMemoRequest) (*Memo, error)
+	// PurgeMemo permanently deletes a memo in the trash.
+	PurgeMemo(context.Context, *PurgeMemoRequest) (*emptypb.Empty, error)
 	// ExportMemos exports memos.
 	ExportMemos(context.Context, *ExportMemosRequest) (*ExportMemosResponse, error)
+	// ExportMemoArchive streams a ZIP archive of the memos of the current user with
+	// their comments, resources and metadata.
+	ExportMemoArchive(*ExportMemoArchiveRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error
+	// ExportMemoSite streams a ZIP of a static HTML site of the public memos of a user.
+	ExportMemoSite(*ExportMemoSiteRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error
+	// ImportMemos imports memos from a ZIP of markdown files, e.g. the content of ExportMemos,
+	// a ZIP of an Obsidian vault or an Evernote ENEX file.
+	ImportMemos(context.Context, *ImportMemosRequest) (*ImportMemosResponse, error)
 	// RenameMemoTag renames a tag for a memo.
 	RenameMemoTag(context.Context, *RenameMemoTagRequest) (*emptypb.Empty, error)
 	// DeleteMemoTag deletes a tag for a memo.
@@ -295,6 +517,21 @@ type MemoServiceServer interface {
 	UpsertMemoReaction(context.Context, *UpsertMemoReactionRequest) (*Reaction, error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(context.Context, *DeleteMemoReactionRequest) (*emptypb.Empty, error)
+	// ListMemoRevisions lists revisions for a memo.
+	ListMemoRevisions(context.Context, *ListMemoRevisionsRequest) (*ListMemoRevisionsResponse, error)
+	// GetMemoRevision gets a revision of a memo.
+	GetMemoRevision(context.Context, *GetMemoRevisionRequest) (*MemoRevision, error)
+	// RestoreMemoRevision restores a memo to the given revision.
+	RestoreMemoRevision(context.Context, *RestoreMemoRevisionRequest) (*Memo, error)
+	// CreateMemoShare creates a link sharing the memo by a secret token.
+	CreateMemoShare(context.Context, *CreateMemoShareRequest) (*MemoShare, error)
+	// ListMemoShares lists the share links of a memo.
+	ListMemoShares(context.Context, *ListMemoSharesRequest) (*ListMemoSharesResponse, error)
+	// RevokeMemoShare revokes a share link of a memo.
+	RevokeMemoShare(context.Context, *RevokeMemoShareRequest) (*emptypb.Empty, error)
+	// SyncMemos returns the memos and resources of the current user changed since the sync token,
+	// along with the tombstones of the deleted and archived ones.
+	SyncMemos(context.Context, *SyncMemosRequest) (*SyncMemosResponse, error)
 	mustEmbedUnimplementedMemoServiceServer()
 }
 
@@ -311,6 +548,9 @@ func (UnimplementedMemoServiceServer) CreateMemo(context.Context, *CreateMemoReq
 func (UnimplementedMemoServiceServer) ListMemos(context.Context, *ListMemosRequest) (*ListMemosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMemos not implemented")
 }
+func (UnimplementedMemoServiceServer) SearchMemos(context.Context, *SearchMemosRequest) (*SearchMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMemos not implemented")
+}
 func (UnimplementedMemoServiceServer) GetMemo(context.Context, *GetMemoRequest) (*Memo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMemo not implemented")
 }
@@ -320,9 +560,27 @@ func (UnimplementedMemoServiceServer) UpdateMemo(context.Context, *UpdateMemoReq
 func (UnimplementedMemoServiceServer) DeleteMemo(context.Context, *DeleteMemoRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMemo not implemented")
 }
+func (UnimplementedMemoServiceServer) ListTrashedMemos(context.Context, *ListTrashedMemosRequest) (*ListTrashedMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrashedMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) RestoreMemo(context.Context, *RestoreMemoRequest) (*Memo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreMemo not implemented")
+}
+func (UnimplementedMemoServiceServer) PurgeMemo(context.Context, *PurgeMemoRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeMemo not implemented")
+}
 func (UnimplementedMemoServiceServer) ExportMemos(context.Context, *ExportMemosRequest) (*ExportMemosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportMemos not implemented")
 }
+func (UnimplementedMemoServiceServer) ExportMemoArchive(*ExportMemoArchiveRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportMemoArchive not implemented")
+}
+func (UnimplementedMemoServiceServer) ExportMemoSite(*ExportMemoSiteRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportMemoSite not implemented")
+}
+func (UnimplementedMemoServiceServer) ImportMemos(context.Context, *ImportMemosRequest) (*ImportMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportMemos not implemented")
+}
 func (UnimplementedMemoServiceServer) RenameMemoTag(context.Context, *RenameMemoTagRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenameMemoTag not implemented")
 }
@@ -356,6 +614,27 @@ func (UnimplementedMemoServiceServer) UpsertMemoReaction(context.Context, *Upser
 func (UnimplementedMemoServiceServer) DeleteMemoReaction(context.Context, *DeleteMemoReactionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMemoReaction not implemented")
 }
+func (UnimplementedMemoServiceServer) ListMemoRevisions(context.Context, *ListMemoRevisionsRequest) (*ListMemoRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoRevisions not implemented")
+}
+func (UnimplementedMemoServiceServer) GetMemoRevision(context.Context, *GetMemoRevisionRequest) (*MemoRevision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemoRevision not implemented")
+}
+func (UnimplementedMemoServiceServer) RestoreMemoRevision(context.Context, *RestoreMemoRevisionRequest) (*Memo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreMemoRevision not implemented")
+}
+func (UnimplementedMemoServiceServer) CreateMemoShare(context.Context, *CreateMemoShareRequest) (*MemoShare, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMemoShare not implemented")
+}
+func (UnimplementedMemoServiceServer) ListMemoShares(context.Context, *ListMemoSharesRequest) (*ListMemoSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoShares not implemented")
+}
+func (UnimplementedMemoServiceServer) RevokeMemoShare(context.Context, *RevokeMemoShareRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeMemoShare not implemented")
+}
+func (UnimplementedMemoServiceServer) SyncMemos(context.Context, *SyncMemosRequest) (*SyncMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncMemos not implemented")
+}
 func (UnimplementedMemoServiceServer) mustEmbedUnimplementedMemoServiceServer() {}
 func (UnimplementedMemoServiceServer) testEmbeddedByValue()                     {}
 
@@ -413,6 +692,24 @@ func _MemoService_ListMemos_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_SearchMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).SearchMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_SearchMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).SearchMemos(ctx, req.(*SearchMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemoService_GetMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMemoRequest)
 	if err := dec(in); err != nil {
@@ -467,6 +764,60 @@ func _MemoService_DeleteMemo_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_ListTrashedMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashedMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListTrashedMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListTrashedMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListTrashedMemos(ctx, req.(*ListTrashedMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_RestoreMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).RestoreMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_RestoreMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).RestoreMemo(ctx, req.(*RestoreMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_PurgeMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).PurgeMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_PurgeMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).PurgeMemo(ctx, req.(*PurgeMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemoService_ExportMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExportMemosRequest)
 	if err := dec(in); err != nil {
@@ -485,6 +836,46 @@ func _MemoService_ExportMemos_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_ExportMemoArchive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportMemoArchiveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MemoServiceServer).ExportMemoArchive(m, &grpc.GenericServerStream[ExportMemoArchiveRequest, httpbody.HttpBody]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MemoService_ExportMemoArchiveServer = grpc.ServerStreamingServer[httpbody.HttpBody]
+
+func _MemoService_ExportMemoSite_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportMemoSiteRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MemoServiceServer).ExportMemoSite(m, &grpc.GenericServerStream[ExportMemoSiteRequest, httpbody.HttpBody]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MemoService_ExportMemoSiteServer = grpc.ServerStreamingServer[httpbody.HttpBody]
+
+func _MemoService_ImportMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ImportMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ImportMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ImportMemos(ctx, req.(*ImportMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemoService_RenameMemoTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RenameMemoTagRequest)
 	if err := dec(in); err != nil {
@@ -683,6 +1074,132 @@ func _MemoService_DeleteMemoReaction_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_ListMemoRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoRevisions(ctx, req.(*ListMemoRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetMemoRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetMemoRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetMemoRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetMemoRevision(ctx, req.(*GetMemoRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_RestoreMemoRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMemoRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).RestoreMemoRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_RestoreMemoRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).RestoreMemoRevision(ctx, req.(*RestoreMemoRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_CreateMemoShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMemoShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).CreateMemoShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_CreateMemoShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).CreateMemoShare(ctx, req.(*CreateMemoShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListMemoShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoShares(ctx, req.(*ListMemoSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_RevokeMemoShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeMemoShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).RevokeMemoShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_RevokeMemoShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).RevokeMemoShare(ctx, req.(*RevokeMemoShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_SyncMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).SyncMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_SyncMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).SyncMemos(ctx, req.(*SyncMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MemoService_ServiceDesc is the grpc.ServiceDesc for MemoService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -698,6 +1215,10 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMemos",
 			Handler:    _MemoService_ListMemos_Handler,
 		},
+		{
+			MethodName: "SearchMemos",
+			Handler:    _MemoService_SearchMemos_Handler,
+		},
 		{
 			MethodName: "GetMemo",
 			Handler:    _MemoService_GetMemo_Handler,
@@ -710,10 +1231,26 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMemo",
 			Handler:    _MemoService_DeleteMemo_Handler,
 		},
+		{
+			MethodName: "ListTrashedMemos",
+			Handler:    _MemoService_ListTrashedMemos_Handler,
+		},
+		{
+			MethodName: "RestoreMemo",
+			Handler:    _MemoService_RestoreMemo_Handler,
+		},
+		{
+			MethodName: "PurgeMemo",
+			Handler:    _MemoService_PurgeMemo_Handler,
+		},
 		{
 			MethodName: "ExportMemos",
 			Handler:    _MemoService_ExportMemos_Handler,
 		},
+		{
+			MethodName: "ImportMemos",
+			Handler:    _MemoService_ImportMemos_Handler,
+		},
 		{
 			MethodName: "RenameMemoTag",
 			Handler:    _MemoService_RenameMemoTag_Handler,
@@ -758,7 +1295,46 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMemoReaction",
 			Handler:    _MemoService_DeleteMemoReaction_Handler,
 		},
+		{
+			MethodName: "ListMemoRevisions",
+			Handler:    _MemoService_ListMemoRevisions_Handler,
+		},
+		{
+			MethodName: "GetMemoRevision",
+			Handler:    _MemoService_GetMemoRevision_Handler,
+		},
+		{
+			MethodName: "RestoreMemoRevision",
+			Handler:    _MemoService_RestoreMemoRevision_Handler,
+		},
+		{
+			MethodName: "CreateMemoShare",
+			Handler:    _MemoService_CreateMemoShare_Handler,
+		},
+		{
+			MethodName: "ListMemoShares",
+			Handler:    _MemoService_ListMemoShares_Handler,
+		},
+		{
+			MethodName: "RevokeMemoShare",
+			Handler:    _MemoService_RevokeMemoShare_Handler,
+		},
+		{
+			MethodName: "SyncMemos",
+			Handler:    _MemoService_SyncMemos_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportMemoArchive",
+			Handler:       _MemoService_ExportMemoArchive_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportMemoSite",
+			Handler:       _MemoService_ExportMemoSite_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/memo_service.proto",
 }
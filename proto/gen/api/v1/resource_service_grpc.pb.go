@@ -34,6 +34,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ResourceServiceClient interface {
 	// CreateResource creates a new resource.
+	// The retries with the same Idempotency-Key header or metadata return the resource created first.
 	CreateResource(ctx context.Context, in *CreateResourceRequest, opts ...grpc.CallOption) (*Resource, error)
 	// ListResources lists all resources.
 	ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error)
@@ -120,6 +121,7 @@ func (c *resourceServiceClient) DeleteResource(ctx context.Context, in *DeleteRe
 // for forward compatibility.
 type ResourceServiceServer interface {
 	// CreateResource creates a new resource.
+	// The retries with the same Idempotency-Key header or metadata return the resource created first.
 	CreateResource(context.Context, *CreateResourceRequest) (*Resource, error)
 	// ListResources lists all resources.
 	ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error)
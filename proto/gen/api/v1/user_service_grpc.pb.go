@@ -1,8 +1,8 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.5.1
-// - protoc             v3.19.4
-// source: user_service.proto
+// - protoc             (unknown)
+// source: api/v1/user_service.proto
 
 package apiv1
 
@@ -22,6 +22,7 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	UserService_ListUsers_FullMethodName             = "/memos.api.v1.UserService/ListUsers"
+	UserService_SearchUsers_FullMethodName           = "/memos.api.v1.UserService/SearchUsers"
 	UserService_GetUser_FullMethodName               = "/memos.api.v1.UserService/GetUser"
 	UserService_GetUserByUsername_FullMethodName     = "/memos.api.v1.UserService/GetUserByUsername"
 	UserService_GetUserAvatarBinary_FullMethodName   = "/memos.api.v1.UserService/GetUserAvatarBinary"
@@ -32,6 +33,15 @@ const (
 	UserService_GetUserStats_FullMethodName          = "/memos.api.v1.UserService/GetUserStats"
 	UserService_IsFollowingUser_FullMethodName       = "/memos.api.v1.UserService/IsFollowingUser"
 	UserService_FollowUser_FullMethodName            = "/memos.api.v1.UserService/FollowUser"
+	UserService_UnfollowUser_FullMethodName          = "/memos.api.v1.UserService/UnfollowUser"
+	UserService_SetFollowTag_FullMethodName          = "/memos.api.v1.UserService/SetFollowTag"
+	UserService_ListFollowCircles_FullMethodName     = "/memos.api.v1.UserService/ListFollowCircles"
+	UserService_ListFollowRequests_FullMethodName    = "/memos.api.v1.UserService/ListFollowRequests"
+	UserService_AcceptFollowRequest_FullMethodName   = "/memos.api.v1.UserService/AcceptFollowRequest"
+	UserService_RejectFollowRequest_FullMethodName   = "/memos.api.v1.UserService/RejectFollowRequest"
+	UserService_BlockUser_FullMethodName             = "/memos.api.v1.UserService/BlockUser"
+	UserService_UnblockUser_FullMethodName           = "/memos.api.v1.UserService/UnblockUser"
+	UserService_ListUserBlocks_FullMethodName        = "/memos.api.v1.UserService/ListUserBlocks"
 	UserService_GetFollowingList_FullMethodName      = "/memos.api.v1.UserService/GetFollowingList"
 	UserService_GetFollowerList_FullMethodName       = "/memos.api.v1.UserService/GetFollowerList"
 	UserService_GetUserSetting_FullMethodName        = "/memos.api.v1.UserService/GetUserSetting"
@@ -51,6 +61,8 @@ const (
 type UserServiceClient interface {
 	// ListUsers returns a list of users.
 	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	// SearchUsers searches users by their profiles.
+	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
 	// GetUser gets a user by name.
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error)
 	// GetUserByUsername gets a user by username.
@@ -69,8 +81,26 @@ type UserServiceClient interface {
 	GetUserStats(ctx context.Context, in *GetUserStatsRequest, opts ...grpc.CallOption) (*UserStats, error)
 	// 判断是否已关注用户
 	IsFollowingUser(ctx context.Context, in *IsFollowingUserRequest, opts ...grpc.CallOption) (*IsFollowingUserResponse, error)
-	// 关注/取消关注用户
+	// FollowUser follows a user, following the same user again is a no-op.
 	FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*UserFollowing, error)
+	// UnfollowUser unfollows a user, unfollowing a user not followed is a no-op.
+	UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SetFollowTag moves a followed user into the circle of the tag.
+	SetFollowTag(ctx context.Context, in *SetFollowTagRequest, opts ...grpc.CallOption) (*UserFollowing, error)
+	// ListFollowCircles lists the circles of the users followed by the current user.
+	ListFollowCircles(ctx context.Context, in *ListFollowCirclesRequest, opts ...grpc.CallOption) (*ListFollowCirclesResponse, error)
+	// ListFollowRequests lists the follow requests to the current user.
+	ListFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (*ListFollowRequestsResponse, error)
+	// AcceptFollowRequest accepts a follow request, the requester follows the current user.
+	AcceptFollowRequest(ctx context.Context, in *AcceptFollowRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RejectFollowRequest rejects a follow request.
+	RejectFollowRequest(ctx context.Context, in *RejectFollowRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// BlockUser blocks or mutes a user for the current user.
+	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*UserBlock, error)
+	// UnblockUser removes a block or a mute of the current user.
+	UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListUserBlocks lists the users blocked or muted by the current user.
+	ListUserBlocks(ctx context.Context, in *ListUserBlocksRequest, opts ...grpc.CallOption) (*ListUserBlocksResponse, error)
 	// 获取关注列表
 	GetFollowingList(ctx context.Context, in *GetFollowingListRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
 	// 获取粉丝列表
@@ -113,6 +143,16 @@ func (c *userServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest,
 	return out, nil
 }
 
+func (c *userServiceClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_SearchUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(User)
@@ -213,6 +253,96 @@ func (c *userServiceClient) FollowUser(ctx context.Context, in *FollowUserReques
 	return out, nil
 }
 
+func (c *userServiceClient) UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_UnfollowUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetFollowTag(ctx context.Context, in *SetFollowTagRequest, opts ...grpc.CallOption) (*UserFollowing, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserFollowing)
+	err := c.cc.Invoke(ctx, UserService_SetFollowTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListFollowCircles(ctx context.Context, in *ListFollowCirclesRequest, opts ...grpc.CallOption) (*ListFollowCirclesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFollowCirclesResponse)
+	err := c.cc.Invoke(ctx, UserService_ListFollowCircles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (*ListFollowRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFollowRequestsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListFollowRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AcceptFollowRequest(ctx context.Context, in *AcceptFollowRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_AcceptFollowRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RejectFollowRequest(ctx context.Context, in *RejectFollowRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_RejectFollowRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*UserBlock, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserBlock)
+	err := c.cc.Invoke(ctx, UserService_BlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_UnblockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUserBlocks(ctx context.Context, in *ListUserBlocksRequest, opts ...grpc.CallOption) (*ListUserBlocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserBlocksResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUserBlocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetFollowingList(ctx context.Context, in *GetFollowingListRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListUsersResponse)
@@ -329,6 +459,8 @@ func (c *userServiceClient) DeleteShortcut(ctx context.Context, in *DeleteShortc
 type UserServiceServer interface {
 	// ListUsers returns a list of users.
 	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	// SearchUsers searches users by their profiles.
+	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
 	// GetUser gets a user by name.
 	GetUser(context.Context, *GetUserRequest) (*User, error)
 	// GetUserByUsername gets a user by username.
@@ -347,8 +479,26 @@ type UserServiceServer interface {
 	GetUserStats(context.Context, *GetUserStatsRequest) (*UserStats, error)
 	// 判断是否已关注用户
 	IsFollowingUser(context.Context, *IsFollowingUserRequest) (*IsFollowingUserResponse, error)
-	// 关注/取消关注用户
+	// FollowUser follows a user, following the same user again is a no-op.
 	FollowUser(context.Context, *FollowUserRequest) (*UserFollowing, error)
+	// UnfollowUser unfollows a user, unfollowing a user not followed is a no-op.
+	UnfollowUser(context.Context, *UnfollowUserRequest) (*emptypb.Empty, error)
+	// SetFollowTag moves a followed user into the circle of the tag.
+	SetFollowTag(context.Context, *SetFollowTagRequest) (*UserFollowing, error)
+	// ListFollowCircles lists the circles of the users followed by the current user.
+	ListFollowCircles(context.Context, *ListFollowCirclesRequest) (*ListFollowCirclesResponse, error)
+	// ListFollowRequests lists the follow requests to the current user.
+	ListFollowRequests(context.Context, *ListFollowRequestsRequest) (*ListFollowRequestsResponse, error)
+	// AcceptFollowRequest accepts a follow request, the requester follows the current user.
+	AcceptFollowRequest(context.Context, *AcceptFollowRequestRequest) (*emptypb.Empty, error)
+	// RejectFollowRequest rejects a follow request.
+	RejectFollowRequest(context.Context, *RejectFollowRequestRequest) (*emptypb.Empty, error)
+	// BlockUser blocks or mutes a user for the current user.
+	BlockUser(context.Context, *BlockUserRequest) (*UserBlock, error)
+	// UnblockUser removes a block or a mute of the current user.
+	UnblockUser(context.Context, *UnblockUserRequest) (*emptypb.Empty, error)
+	// ListUserBlocks lists the users blocked or muted by the current user.
+	ListUserBlocks(context.Context, *ListUserBlocksRequest) (*ListUserBlocksResponse, error)
 	// 获取关注列表
 	GetFollowingList(context.Context, *GetFollowingListRequest) (*ListUsersResponse, error)
 	// 获取粉丝列表
@@ -384,6 +534,9 @@ type UnimplementedUserServiceServer struct{}
 func (UnimplementedUserServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
 }
+func (UnimplementedUserServiceServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
 func (UnimplementedUserServiceServer) GetUser(context.Context, *GetUserRequest) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
 }
@@ -414,6 +567,33 @@ func (UnimplementedUserServiceServer) IsFollowingUser(context.Context, *IsFollow
 func (UnimplementedUserServiceServer) FollowUser(context.Context, *FollowUserRequest) (*UserFollowing, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FollowUser not implemented")
 }
+func (UnimplementedUserServiceServer) UnfollowUser(context.Context, *UnfollowUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowUser not implemented")
+}
+func (UnimplementedUserServiceServer) SetFollowTag(context.Context, *SetFollowTagRequest) (*UserFollowing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFollowTag not implemented")
+}
+func (UnimplementedUserServiceServer) ListFollowCircles(context.Context, *ListFollowCirclesRequest) (*ListFollowCirclesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowCircles not implemented")
+}
+func (UnimplementedUserServiceServer) ListFollowRequests(context.Context, *ListFollowRequestsRequest) (*ListFollowRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowRequests not implemented")
+}
+func (UnimplementedUserServiceServer) AcceptFollowRequest(context.Context, *AcceptFollowRequestRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptFollowRequest not implemented")
+}
+func (UnimplementedUserServiceServer) RejectFollowRequest(context.Context, *RejectFollowRequestRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectFollowRequest not implemented")
+}
+func (UnimplementedUserServiceServer) BlockUser(context.Context, *BlockUserRequest) (*UserBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedUserServiceServer) UnblockUser(context.Context, *UnblockUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedUserServiceServer) ListUserBlocks(context.Context, *ListUserBlocksRequest) (*ListUserBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserBlocks not implemented")
+}
 func (UnimplementedUserServiceServer) GetFollowingList(context.Context, *GetFollowingListRequest) (*ListUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFollowingList not implemented")
 }
@@ -486,6 +666,24 @@ func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SearchUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SearchUsers(ctx, req.(*SearchUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserRequest)
 	if err := dec(in); err != nil {
@@ -666,6 +864,168 @@ func _UserService_FollowUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnfollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnfollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnfollowUser(ctx, req.(*UnfollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetFollowTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFollowTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetFollowTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetFollowTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetFollowTag(ctx, req.(*SetFollowTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListFollowCircles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowCirclesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListFollowCircles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListFollowCircles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListFollowCircles(ctx, req.(*ListFollowCirclesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListFollowRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListFollowRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListFollowRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListFollowRequests(ctx, req.(*ListFollowRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AcceptFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptFollowRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AcceptFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AcceptFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AcceptFollowRequest(ctx, req.(*AcceptFollowRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RejectFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectFollowRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RejectFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RejectFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RejectFollowRequest(ctx, req.(*RejectFollowRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BlockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnblockUser(ctx, req.(*UnblockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUserBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserBlocks(ctx, req.(*ListUserBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetFollowingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetFollowingListRequest)
 	if err := dec(in); err != nil {
@@ -875,6 +1235,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUsers",
 			Handler:    _UserService_ListUsers_Handler,
 		},
+		{
+			MethodName: "SearchUsers",
+			Handler:    _UserService_SearchUsers_Handler,
+		},
 		{
 			MethodName: "GetUser",
 			Handler:    _UserService_GetUser_Handler,
@@ -915,6 +1279,42 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FollowUser",
 			Handler:    _UserService_FollowUser_Handler,
 		},
+		{
+			MethodName: "UnfollowUser",
+			Handler:    _UserService_UnfollowUser_Handler,
+		},
+		{
+			MethodName: "SetFollowTag",
+			Handler:    _UserService_SetFollowTag_Handler,
+		},
+		{
+			MethodName: "ListFollowCircles",
+			Handler:    _UserService_ListFollowCircles_Handler,
+		},
+		{
+			MethodName: "ListFollowRequests",
+			Handler:    _UserService_ListFollowRequests_Handler,
+		},
+		{
+			MethodName: "AcceptFollowRequest",
+			Handler:    _UserService_AcceptFollowRequest_Handler,
+		},
+		{
+			MethodName: "RejectFollowRequest",
+			Handler:    _UserService_RejectFollowRequest_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _UserService_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _UserService_UnblockUser_Handler,
+		},
+		{
+			MethodName: "ListUserBlocks",
+			Handler:    _UserService_ListUserBlocks_Handler,
+		},
 		{
 			MethodName: "GetFollowingList",
 			Handler:    _UserService_GetFollowingList_Handler,
@@ -961,5 +1361,5 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
-	Metadata: "user_service.proto",
+	Metadata: "api/v1/user_service.proto",
 }
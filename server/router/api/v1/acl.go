@@ -25,6 +25,8 @@ const (
 	// user id is extracted from the jwt token subject field.
 	usernameContextKey ContextKey = iota
 	accessTokenContextKey
+	// The key name used to store the memo share of a valid share token in the context.
+	memoShareContextKey
 )
 
 // GRPCAuthInterceptor is the auth interceptor for gRPC server.
@@ -43,6 +45,28 @@ func NewGRPCAuthInterceptor(store *store.Store, secret string) *GRPCAuthIntercep
 
 // AuthenticationInterceptor is the unary interceptor for gRPC API.
 func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := in.authenticateContext(ctx, serverInfo.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err = in.authenticateMemoShare(ctx, serverInfo.FullMethod, request)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, request)
+}
+
+// AuthenticationStreamInterceptor is the stream interceptor for gRPC API.
+func (in *GRPCAuthInterceptor) AuthenticationStreamInterceptor(server any, stream grpc.ServerStream, serverInfo *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := in.authenticateContext(stream.Context(), serverInfo.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(server, &authenticatedServerStream{ServerStream: stream, ctx: ctx})
+}
+
+// authenticateContext returns the context with the authenticated user of the request.
+func (in *GRPCAuthInterceptor) authenticateContext(ctx context.Context, fullMethod string) (context.Context, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, status.Errorf(codes.Unauthenticated, "failed to parse metadata from incoming context")
@@ -54,8 +78,8 @@ func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, re
 
 	username, err := in.authenticate(ctx, accessToken)
 	if err != nil {
-		if isUnauthorizeAllowedMethod(serverInfo.FullMethod) {
-			return handler(ctx, request)
+		if isUnauthorizeAllowedMethod(fullMethod) {
+			return ctx, nil
 		}
 		return nil, err
 	}
@@ -71,13 +95,49 @@ func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, re
 	if user.RowStatus == store.Archived {
 		return nil, errors.Errorf("user %q is archived", username)
 	}
-	if isOnlyForAdminAllowedMethod(serverInfo.FullMethod) && user.Role != store.RoleHost && user.Role != store.RoleAdmin {
+	if isOnlyForAdminAllowedMethod(fullMethod) && user.Role != store.RoleHost && user.Role != store.RoleAdmin {
 		return nil, errors.Errorf("user %q is not admin", username)
 	}
 
 	ctx = context.WithValue(ctx, usernameContextKey, username)
 	ctx = context.WithValue(ctx, accessTokenContextKey, accessToken)
-	return handler(ctx, request)
+	return ctx, nil
+}
+
+// shareTokenRequest is a request that may carry the token of a memo share link.
+type shareTokenRequest interface {
+	GetShareToken() string
+}
+
+// authenticateMemoShare returns the context with the memo share of the share token in the request.
+// The share token is only accepted by the methods allowlisted for it, and an invalid one is rejected.
+func (in *GRPCAuthInterceptor) authenticateMemoShare(ctx context.Context, fullMethod string, request any) (context.Context, error) {
+	if !isShareTokenAllowedMethod(fullMethod) {
+		return ctx, nil
+	}
+	shareRequest, ok := request.(shareTokenRequest)
+	if !ok || shareRequest.GetShareToken() == "" {
+		return ctx, nil
+	}
+	shareToken := shareRequest.GetShareToken()
+	memoShare, err := in.Store.GetMemoShare(ctx, &store.FindMemoShare{Token: &shareToken})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get memo share")
+	}
+	if memoShare == nil || isMemoShareExpired(memoShare) {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid or expired share token")
+	}
+	return context.WithValue(ctx, memoShareContextKey, memoShare), nil
+}
+
+// authenticatedServerStream overrides the context of the stream with the authenticated one.
+type authenticatedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedServerStream) Context() context.Context {
+	return s.ctx
 }
 
 func (in *GRPCAuthInterceptor) authenticate(ctx context.Context, accessToken string) (string, error) {
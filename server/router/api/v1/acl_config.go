@@ -18,8 +18,10 @@ var authenticationAllowlistMethods = map[string]bool{
 	"/memos.api.v1.UserService/SearchUsers":                       true,
 	"/memos.api.v1.MemoService/GetMemo":                           true,
 	"/memos.api.v1.MemoService/ListMemos":                         true,
+	"/memos.api.v1.MemoService/SearchMemos":                       true,
 	"/memos.api.v1.MarkdownService/GetLinkMetadata":               true,
 	"/memos.api.v1.ResourceService/GetResourceBinary":             true,
+	"/memos.api.v1.EventService/WatchEvents":                      true,
 }
 
 // isUnauthorizeAllowedMethod returns whether the method is exempted from authentication.
@@ -27,6 +29,17 @@ func isUnauthorizeAllowedMethod(fullMethodName string) bool {
 	return authenticationAllowlistMethods[fullMethodName]
 }
 
+// shareTokenAllowlistMethods are the methods accepting a memo share token in place of authentication.
+var shareTokenAllowlistMethods = map[string]bool{
+	"/memos.api.v1.MemoService/GetMemo":               true,
+	"/memos.api.v1.ResourceService/GetResourceBinary": true,
+}
+
+// isShareTokenAllowedMethod returns whether the method accepts a memo share token.
+func isShareTokenAllowedMethod(fullMethodName string) bool {
+	return shareTokenAllowlistMethods[fullMethodName]
+}
+
 var allowedMethodsOnlyForAdmin = map[string]bool{
 	"/memos.api.v1.UserService/CreateUser":                      true,
 	"/memos.api.v1.WorkspaceSettingService/SetWorkspaceSetting": true,
@@ -0,0 +1,520 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) CreateChannel(ctx context.Context, request *v1pb.CreateChannelRequest) (*v1pb.Channel, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if request.Channel == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "channel is required")
+	}
+
+	memberIDList := []int32{}
+	for _, member := range request.Channel.Members {
+		userID, err := ExtractUserIDFromName(member)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid member: %v", err)
+		}
+		// The current user is always a member.
+		if userID == user.ID || slices.Contains(memberIDList, userID) {
+			continue
+		}
+		memberUser, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		}
+		if memberUser == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "member %s not found", member)
+		}
+		blocked, err := s.isBlockedByUser(ctx, memberUser.ID, user.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check user block: %v", err)
+		}
+		if blocked {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+		memberIDList = append(memberIDList, userID)
+	}
+
+	create := &store.Channel{
+		CreatorID: user.ID,
+	}
+	switch request.Channel.Type {
+	case v1pb.Channel_DIRECT:
+		if len(memberIDList) != 1 {
+			return nil, status.Errorf(codes.InvalidArgument, "a direct channel has exactly one other member")
+		}
+		channel, err := s.findDirectChannel(ctx, user.ID, memberIDList[0])
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to find direct channel: %v", err)
+		}
+		if channel != nil {
+			return s.convertChannelFromStore(ctx, user.ID, channel)
+		}
+		create.Type = store.ChannelTypeDirect
+	case v1pb.Channel_GROUP:
+		create.Type = store.ChannelTypeGroup
+		create.Name = strings.TrimSpace(request.Channel.Title)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid channel type")
+	}
+
+	var channel *store.Channel
+	if err := s.Store.WithTx(ctx, func(tx *store.Store) error {
+		var err error
+		channel, err = tx.CreateChannel(ctx, create)
+		if err != nil {
+			return errors.Wrap(err, "failed to create channel")
+		}
+		for _, userID := range append([]int32{user.ID}, memberIDList...) {
+			if _, err := tx.CreateChannelMember(ctx, &store.ChannelMember{
+				ChannelID: channel.ID,
+				UserID:    userID,
+			}); err != nil {
+				return errors.Wrap(err, "failed to create channel member")
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create channel: %v", err)
+	}
+	return s.convertChannelFromStore(ctx, user.ID, channel)
+}
+
+func (s *APIV1Service) ListChannels(ctx context.Context, _ *v1pb.ListChannelsRequest) (*v1pb.ListChannelsResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	channels, err := s.Store.ListChannels(ctx, &store.FindChannel{MemberID: &user.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list channels: %v", err)
+	}
+	channelMessages, err := s.convertChannelsFromStore(ctx, user.ID, channels)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert channels: %v", err)
+	}
+	return &v1pb.ListChannelsResponse{
+		Channels: channelMessages,
+	}, nil
+}
+
+func (s *APIV1Service) GetChannel(ctx context.Context, request *v1pb.GetChannelRequest) (*v1pb.Channel, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	channel, err := s.getChannelOfMember(ctx, request.Name, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	return s.convertChannelFromStore(ctx, user.ID, channel)
+}
+
+func (s *APIV1Service) MarkChannelRead(ctx context.Context, request *v1pb.MarkChannelReadRequest) (*emptypb.Empty, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	channel, err := s.getChannelOfMember(ctx, request.Name, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	limit := 1
+	lastMessage, err := s.Store.GetMessage(ctx, &store.FindMessage{
+		ChannelID: &channel.ID,
+		Limit:     &limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get message: %v", err)
+	}
+	if lastMessage == nil {
+		return &emptypb.Empty{}, nil
+	}
+	if err := s.Store.UpdateChannelMember(ctx, &store.UpdateChannelMember{
+		ChannelID:         channel.ID,
+		UserID:            user.ID,
+		LastReadMessageID: &lastMessage.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update channel member: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) CreateMessage(ctx context.Context, request *v1pb.CreateMessageRequest) (*v1pb.Message, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	channel, err := s.getChannelOfMember(ctx, request.Parent, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if request.Message == nil || strings.TrimSpace(request.Message.Content) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "content is required")
+	}
+	message, err := s.createMessage(ctx, channel, &store.Message{
+		ChannelID: channel.ID,
+		CreatorID: user.ID,
+		Content:   request.Message.Content,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.convertMessageFromStore(ctx, message)
+}
+
+func (s *APIV1Service) ListMessages(ctx context.Context, request *v1pb.ListMessagesRequest) (*v1pb.ListMessagesResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	channel, err := s.getChannelOfMember(ctx, request.Parent, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	messageFind := &store.FindMessage{
+		ChannelID: &channel.ID,
+	}
+	var limit int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		messageFind.Cursor = convertCursorFromPageToken(&pageToken)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limitPlusOne := limit + 1
+	messageFind.Limit = &limitPlusOne
+	messages, err := s.Store.ListMessages(ctx, messageFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list messages: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(messages) == limitPlusOne {
+		messages = messages[:limit]
+		nextPageToken, err = getCursorPageToken(limit, &store.Cursor{
+			ID: messages[len(messages)-1].ID,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+	response := &v1pb.ListMessagesResponse{
+		NextPageToken: nextPageToken,
+	}
+	for _, message := range messages {
+		messageMessage, err := s.convertMessageFromStore(ctx, message)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert message")
+		}
+		response.Messages = append(response.Messages, messageMessage)
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) CreateMemoMessage(ctx context.Context, request *v1pb.CreateMemoMessageRequest) (*v1pb.Message, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	// Only the memos the sender can read are shared.
+	canRead, err := s.canReadMemo(ctx, user, memo)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check memo access: %v", err)
+	}
+	if !canRead || memo.RowStatus == store.Trashed {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	channel, err := s.getChannelOfMember(ctx, request.Channel, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	create := &store.Message{
+		ChannelID: channel.ID,
+		CreatorID: user.ID,
+		MemoID:    &memo.ID,
+	}
+	if request.Message != nil {
+		create.Content = request.Message.Content
+	}
+	message, err := s.createMessage(ctx, channel, create)
+	if err != nil {
+		return nil, err
+	}
+	return s.convertMessageFromStore(ctx, message)
+}
+
+// createMessage creates the message in the channel, and notifies the other member of a direct channel
+// by an inbox when the message is the first one the member has not read.
+func (s *APIV1Service) createMessage(ctx context.Context, channel *store.Channel, create *store.Message) (*store.Message, error) {
+	contentLengthLimit, err := s.getContentLengthLimit(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(create.Content) > contentLengthLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "content too long (max %d characters)", contentLengthLimit)
+	}
+	// The users blocked by any other member can't send messages to the channel.
+	members, err := s.Store.ListChannelMembers(ctx, &store.FindChannelMember{ChannelID: &channel.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list channel members: %v", err)
+	}
+	for _, member := range members {
+		if member.UserID == create.CreatorID {
+			continue
+		}
+		blocked, err := s.isBlockedByUser(ctx, member.UserID, create.CreatorID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check user block: %v", err)
+		}
+		if blocked {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+	}
+
+	var message *store.Message
+	inboxes := []*store.Inbox{}
+	if err := s.Store.WithTx(ctx, func(tx *store.Store) error {
+		var err error
+		message, err = tx.CreateMessage(ctx, create)
+		if err != nil {
+			return errors.Wrap(err, "failed to create message")
+		}
+		if err := tx.UpdateChannel(ctx, &store.UpdateChannel{
+			ID:        channel.ID,
+			UpdatedTs: &message.CreatedTs,
+		}); err != nil {
+			return errors.Wrap(err, "failed to update channel")
+		}
+		// The sender has read the channel up to the message.
+		if err := tx.UpdateChannelMember(ctx, &store.UpdateChannelMember{
+			ChannelID:         channel.ID,
+			UserID:            message.CreatorID,
+			LastReadMessageID: &message.ID,
+		}); err != nil {
+			return errors.Wrap(err, "failed to update channel member")
+		}
+
+		if channel.Type != store.ChannelTypeDirect {
+			return nil
+		}
+		members, err := tx.ListChannelMembers(ctx, &store.FindChannelMember{ChannelID: &channel.ID})
+		if err != nil {
+			return errors.Wrap(err, "failed to list channel members")
+		}
+		for _, member := range members {
+			if member.UserID == message.CreatorID {
+				continue
+			}
+			unreadCounts, err := tx.CountUnreadMessages(ctx, member.UserID)
+			if err != nil {
+				return errors.Wrap(err, "failed to count unread messages")
+			}
+			if unreadCounts[channel.ID] != 1 {
+				continue
+			}
+			inbox, err := tx.CreateInbox(ctx, &store.Inbox{
+				SenderID:   message.CreatorID,
+				ReceiverID: member.UserID,
+				Status:     store.UNREAD,
+				Message: &storepb.InboxMessage{
+					Type:      storepb.InboxMessage_DIRECT_MESSAGE,
+					ChannelId: &channel.ID,
+					MessageId: &message.ID,
+				},
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to create inbox")
+			}
+			inboxes = append(inboxes, inbox)
+		}
+		return nil
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create message: %v", err)
+	}
+	for _, inbox := range inboxes {
+		s.publishInboxEvent(v1pb.Event_INBOX_CREATED, inbox)
+	}
+	return message, nil
+}
+
+// getChannelOfMember returns the channel of the name, the user must be one of its members.
+func (s *APIV1Service) getChannelOfMember(ctx context.Context, name string, userID int32) (*store.Channel, error) {
+	channelID, err := ExtractChannelIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid channel name: %v", err)
+	}
+	channel, err := s.Store.GetChannel(ctx, &store.FindChannel{ID: &channelID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get channel: %v", err)
+	}
+	if channel == nil {
+		return nil, status.Errorf(codes.NotFound, "channel not found")
+	}
+	member, err := s.Store.GetChannelMember(ctx, &store.FindChannelMember{
+		ChannelID: &channel.ID,
+		UserID:    &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get channel member: %v", err)
+	}
+	if member == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return channel, nil
+}
+
+// findDirectChannel returns the direct channel between the two users, it's nil if there is none.
+func (s *APIV1Service) findDirectChannel(ctx context.Context, userID, otherUserID int32) (*store.Channel, error) {
+	directType := store.ChannelTypeDirect
+	channels, err := s.Store.ListChannels(ctx, &store.FindChannel{
+		MemberID: &userID,
+		Type:     &directType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(channels) == 0 {
+		return nil, nil
+	}
+	channelIDList := []int32{}
+	for _, channel := range channels {
+		channelIDList = append(channelIDList, channel.ID)
+	}
+	members, err := s.Store.ListChannelMembers(ctx, &store.FindChannelMember{
+		ChannelIDList: channelIDList,
+		UserID:        &otherUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, channel := range channels {
+		for _, member := range members {
+			if member.ChannelID == channel.ID {
+				return channel, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (s *APIV1Service) convertChannelFromStore(ctx context.Context, userID int32, channel *store.Channel) (*v1pb.Channel, error) {
+	channelMessages, err := s.convertChannelsFromStore(ctx, userID, []*store.Channel{channel})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert channel: %v", err)
+	}
+	return channelMessages[0], nil
+}
+
+// convertChannelsFromStore converts the channels of the user, with their members and unread counts loaded at once.
+func (s *APIV1Service) convertChannelsFromStore(ctx context.Context, userID int32, channels []*store.Channel) ([]*v1pb.Channel, error) {
+	channelMessages := []*v1pb.Channel{}
+	if len(channels) == 0 {
+		return channelMessages, nil
+	}
+	channelIDList := []int32{}
+	for _, channel := range channels {
+		channelIDList = append(channelIDList, channel.ID)
+	}
+	members, err := s.Store.ListChannelMembers(ctx, &store.FindChannelMember{ChannelIDList: channelIDList})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list channel members")
+	}
+	unreadCounts, err := s.Store.CountUnreadMessages(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to count unread messages")
+	}
+
+	for _, channel := range channels {
+		channelMessage := &v1pb.Channel{
+			Name:        fmt.Sprintf("%s%d", ChannelNamePrefix, channel.ID),
+			Type:        convertChannelTypeFromStore(channel.Type),
+			Title:       channel.Name,
+			Creator:     fmt.Sprintf("%s%d", UserNamePrefix, channel.CreatorID),
+			CreateTime:  timestamppb.New(time.Unix(channel.CreatedTs, 0)),
+			UpdateTime:  timestamppb.New(time.Unix(channel.UpdatedTs, 0)),
+			UnreadCount: unreadCounts[channel.ID],
+		}
+		for _, member := range members {
+			if member.ChannelID == channel.ID {
+				channelMessage.Members = append(channelMessage.Members, fmt.Sprintf("%s%d", UserNamePrefix, member.UserID))
+			}
+		}
+		channelMessages = append(channelMessages, channelMessage)
+	}
+	return channelMessages, nil
+}
+
+func (s *APIV1Service) convertMessageFromStore(ctx context.Context, message *store.Message) (*v1pb.Message, error) {
+	messageMessage := &v1pb.Message{
+		Name:       getMessageName(message.ChannelID, message.ID),
+		Creator:    fmt.Sprintf("%s%d", UserNamePrefix, message.CreatorID),
+		Content:    message.Content,
+		CreateTime: timestamppb.New(time.Unix(message.CreatedTs, 0)),
+	}
+	if message.MemoID != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: message.MemoID, ExcludeContent: true})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get memo")
+		}
+		if memo != nil {
+			memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+			messageMessage.Memo = &memoName
+		}
+	}
+	return messageMessage, nil
+}
+
+func getMessageName(channelID, messageID int32) string {
+	return fmt.Sprintf("%s%d/%s%d", ChannelNamePrefix, channelID, MessageNamePrefix, messageID)
+}
+
+func convertChannelTypeFromStore(channelType store.ChannelType) v1pb.Channel_Type {
+	switch channelType {
+	case store.ChannelTypeDirect:
+		return v1pb.Channel_DIRECT
+	case store.ChannelTypeGroup:
+		return v1pb.Channel_GROUP
+	default:
+		return v1pb.Channel_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,38 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestBlockedUserCannotSendGroupMessages(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	creator := createTestingUser(ctx, t, s, "creator", store.RoleUser)
+	blocker := createTestingUser(ctx, t, s, "blocker", store.RoleUser)
+	blocked := createTestingUser(ctx, t, s, "blocked", store.RoleUser)
+	channel, err := s.CreateChannel(withTestingUser(ctx, creator), &v1pb.CreateChannelRequest{Channel: &v1pb.Channel{
+		Type:    v1pb.Channel_GROUP,
+		Title:   "team",
+		Members: []string{getUserPrincipal(blocker), getUserPrincipal(blocked)},
+	}})
+	require.NoError(t, err)
+	blockedCtx := withTestingUser(ctx, blocked)
+	_, err = s.CreateMessage(blockedCtx, &v1pb.CreateMessageRequest{Parent: channel.Name, Message: &v1pb.Message{Content: "hello"}})
+	require.NoError(t, err)
+
+	// Once blocked by a member, the user can't send messages to the channels shared with the member.
+	_, err = s.Store.UpsertUserBlock(ctx, &store.UserBlock{UserID: blocker.ID, BlockedUserID: blocked.ID, Type: store.UserBlockTypeBlock})
+	require.NoError(t, err)
+	_, err = s.CreateMessage(blockedCtx, &v1pb.CreateMessageRequest{Parent: channel.Name, Message: &v1pb.Message{Content: "hello again"}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.CreateMessage(withTestingUser(ctx, creator), &v1pb.CreateMessageRequest{Parent: channel.Name, Message: &v1pb.Message{Content: "hi"}})
+	require.NoError(t, err)
+}
@@ -1,10 +1,15 @@
 package v1
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	"github.com/usememos/memos/store"
@@ -21,6 +26,8 @@ func convertStateFromStore(rowStatus store.RowStatus) v1pb.State {
 		return v1pb.State_NORMAL
 	case store.Archived:
 		return v1pb.State_ARCHIVED
+	case store.Trashed:
+		return v1pb.State_TRASHED
 	default:
 		return v1pb.State_STATE_UNSPECIFIED
 	}
@@ -32,6 +39,8 @@ func convertStateToStore(state v1pb.State) store.RowStatus {
 		return store.Normal
 	case v1pb.State_ARCHIVED:
 		return store.Archived
+	case v1pb.State_TRASHED:
+		return store.Trashed
 	default:
 		return store.Normal
 	}
@@ -44,6 +53,29 @@ func getPageToken(limit int, offset int) (string, error) {
 	})
 }
 
+// getCursorPageToken returns a page token that continues after the given cursor.
+func getCursorPageToken(limit int, cursor *store.Cursor) (string, error) {
+	return marshalPageToken(&v1pb.PageToken{
+		Limit: int32(limit),
+		Cursor: &v1pb.PageToken_Cursor{
+			Id:     cursor.ID,
+			Ts:     cursor.Ts,
+			Pinned: cursor.Pinned,
+		},
+	})
+}
+
+func convertCursorFromPageToken(pageToken *v1pb.PageToken) *store.Cursor {
+	if pageToken.Cursor == nil {
+		return nil
+	}
+	return &store.Cursor{
+		ID:     pageToken.Cursor.Id,
+		Ts:     pageToken.Cursor.Ts,
+		Pinned: pageToken.Cursor.Pinned,
+	}
+}
+
 func marshalPageToken(pageToken *v1pb.PageToken) (string, error) {
 	b, err := proto.Marshal(pageToken)
 	if err != nil {
@@ -63,6 +95,46 @@ func unmarshalPageToken(s string, pageToken *v1pb.PageToken) error {
 	return nil
 }
 
+func marshalSyncToken(syncToken *v1pb.SyncToken) (string, error) {
+	b, err := proto.Marshal(syncToken)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to marshal sync token")
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+func unmarshalSyncToken(s string, syncToken *v1pb.SyncToken) error {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decode sync token")
+	}
+	if err := proto.Unmarshal(b, syncToken); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal sync token")
+	}
+	return nil
+}
+
 func isSuperUser(user *store.User) bool {
 	return user.Role == store.RoleAdmin || user.Role == store.RoleHost
 }
+
+// getEtag returns an opaque etag of a version of a resource, derived from the fields identifying the version.
+func getEtag(fields ...string) string {
+	hash := sha256.New()
+	for _, field := range fields {
+		hash.Write([]byte(field))
+		// Separate the fields, so ("ab", "c") and ("a", "bc") don't collide.
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil)[:16])
+}
+
+// newEtagMismatchError returns the error of an update with a stale etag.
+// The current version is attached to the error details, so clients can merge the changes.
+func newEtagMismatchError(current protoadapt.MessageV1) error {
+	st, err := status.New(codes.Aborted, "etag mismatch, the resource has been modified since it was read").WithDetails(current)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to build etag mismatch error: %v", err)
+	}
+	return st.Err()
+}
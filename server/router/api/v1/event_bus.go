@@ -0,0 +1,253 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// eventSubscriberBufferSize is the number of events buffered for a subscriber.
+// A subscriber falling further behind is dropped, and has to reconnect and reload.
+const eventSubscriberBufferSize = 64
+
+// event is an event published to the event bus, along with what decides who receives it.
+type event struct {
+	message *v1pb.Event
+	// memo is the memo of the event, the event is delivered to the users who can read it.
+	memo *store.Memo
+	// memoACLPrincipals are the user principals of the memo ACL when the event is published, with the groups expanded to their members,
+	// so the event of a deleted memo still reaches the users it was shared with.
+	memoACLPrincipals []string
+	// memoFollowerIDs are the followers of the creator of a FOLLOWERS memo when the event is published.
+	memoFollowerIDs []int32
+	// memoMuterIDs are the users who muted the creator of the memo when the event is published, the event is not delivered to them.
+	memoMuterIDs []int32
+	// receiverID is the receiver of the inbox events, which are only delivered to them.
+	receiverID int32
+}
+
+// canReceive returns whether the user can receive the event, it matches the visibility of ListMemos.
+func (e *event) canReceive(user *store.User) bool {
+	if e.memo != nil {
+		if user != nil && slices.Contains(e.memoMuterIDs, user.ID) {
+			return false
+		}
+		if canReadMemoByVisibility(user, e.memo) {
+			return true
+		}
+		if user == nil {
+			return false
+		}
+		return slices.Contains(e.memoACLPrincipals, getUserPrincipal(user)) || slices.Contains(e.memoFollowerIDs, user.ID)
+	}
+	return user != nil && user.ID == e.receiverID
+}
+
+// eventBus is an in-process event bus, delivering the events published by the API handlers to the watchers.
+type eventBus struct {
+	mutex       sync.Mutex
+	subscribers map[*eventSubscriber]bool
+}
+
+// eventSubscriber is a watcher of the events a user can receive.
+type eventSubscriber struct {
+	user  *store.User
+	types map[v1pb.Event_Type]bool
+	// events is closed when the subscriber is dropped for falling behind.
+	events chan *v1pb.Event
+}
+
+func newEventBus() *eventBus {
+	return &eventBus{
+		subscribers: map[*eventSubscriber]bool{},
+	}
+}
+
+// Subscribe subscribes the user to the events of the types, all the types are subscribed if empty.
+func (b *eventBus) Subscribe(user *store.User, types []v1pb.Event_Type) *eventSubscriber {
+	subscriber := &eventSubscriber{
+		user:   user,
+		types:  map[v1pb.Event_Type]bool{},
+		events: make(chan *v1pb.Event, eventSubscriberBufferSize),
+	}
+	for _, eventType := range types {
+		subscriber.types[eventType] = true
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.subscribers[subscriber] = true
+	return subscriber
+}
+
+func (b *eventBus) Unsubscribe(subscriber *eventSubscriber) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	delete(b.subscribers, subscriber)
+}
+
+// Publish delivers the event to the subscribers who can receive it without blocking.
+func (b *eventBus) Publish(e *event) {
+	if b == nil {
+		return
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	for subscriber := range b.subscribers {
+		if len(subscriber.types) > 0 && !subscriber.types[e.message.Type] {
+			continue
+		}
+		if !e.canReceive(subscriber.user) {
+			continue
+		}
+		select {
+		case subscriber.events <- e.message:
+		default:
+			delete(b.subscribers, subscriber)
+			close(subscriber.events)
+		}
+	}
+}
+
+// publishMemoEvent publishes the event of the memo, the memo message is the comment of the comment events.
+func (s *APIV1Service) publishMemoEvent(ctx context.Context, eventType v1pb.Event_Type, memo *store.Memo, memoMessage *v1pb.Memo, relatedMemo *store.Memo) {
+	if s.eventBus == nil {
+		return
+	}
+	message := &v1pb.Event{
+		Type:       eventType,
+		CreateTime: timestamppb.New(time.Now()),
+		Memo:       memoMessage,
+	}
+	if relatedMemo != nil {
+		message.RelatedMemo = fmt.Sprintf("%s%s", MemoNamePrefix, relatedMemo.UID)
+	}
+	s.publishEventOfMemo(ctx, message, memo)
+}
+
+// publishMemosEvent publishes the events of the memos changed in bulk, which are reloaded from the store.
+func (s *APIV1Service) publishMemosEvent(ctx context.Context, eventType v1pb.Event_Type, memos []*store.Memo) {
+	if s.eventBus == nil || len(memos) == 0 {
+		return
+	}
+	memoIDList := []int32{}
+	for _, memo := range memos {
+		memoIDList = append(memoIDList, memo.ID)
+	}
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{IDList: memoIDList})
+	if err != nil {
+		slog.Warn("Failed to list memos of the events", slog.Any("err", err))
+		return
+	}
+	memoMessages, err := s.convertMemosFromStore(ctx, memos)
+	if err != nil {
+		slog.Warn("Failed to convert memos of the events", slog.Any("err", err))
+		return
+	}
+	for i, memo := range memos {
+		s.publishMemoEvent(ctx, eventType, memo, memoMessages[i], nil)
+	}
+}
+
+// publishReactionEvent publishes the event of the reaction, to the users who can read the reacted memo.
+func (s *APIV1Service) publishReactionEvent(ctx context.Context, eventType v1pb.Event_Type, reaction *v1pb.Reaction) {
+	if s.eventBus == nil {
+		return
+	}
+	memoUID, err := ExtractMemoUIDFromName(reaction.ContentId)
+	if err != nil {
+		// Only the reactions of memos are published.
+		return
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		slog.Warn("Failed to get memo of the event", slog.Any("err", err))
+		return
+	}
+	if memo == nil {
+		return
+	}
+	s.publishEventOfMemo(ctx, &v1pb.Event{
+		Type:        eventType,
+		CreateTime:  timestamppb.New(time.Now()),
+		RelatedMemo: reaction.ContentId,
+		Reaction:    reaction,
+	}, memo)
+}
+
+// publishEventOfMemo publishes the event to the users who can read the memo.
+func (s *APIV1Service) publishEventOfMemo(ctx context.Context, message *v1pb.Event, memo *store.Memo) {
+	memoACLs, err := s.Store.ListMemoACLs(ctx, &store.FindMemoACL{MemoID: &memo.ID})
+	if err != nil {
+		slog.Warn("Failed to list memo acl of the event", slog.Any("err", err))
+		return
+	}
+	e := &event{
+		message: message,
+		memo:    memo,
+	}
+	groupIDList := []int32{}
+	for _, memoACL := range memoACLs {
+		if groupID, err := ExtractGroupIDFromName(memoACL.Principal); err == nil {
+			groupIDList = append(groupIDList, groupID)
+			continue
+		}
+		e.memoACLPrincipals = append(e.memoACLPrincipals, memoACL.Principal)
+	}
+	// The groups are expanded to the principals of their members, which canReceive matches.
+	if len(groupIDList) > 0 {
+		userGroupMembers, err := s.Store.ListUserGroupMembers(ctx, &store.FindUserGroupMember{GroupIDList: groupIDList})
+		if err != nil {
+			slog.Warn("Failed to list group members of the event", slog.Any("err", err))
+			return
+		}
+		for _, userGroupMember := range userGroupMembers {
+			e.memoACLPrincipals = append(e.memoACLPrincipals, fmt.Sprintf("%s%d", UserNamePrefix, userGroupMember.UserID))
+		}
+	}
+	muteType := store.UserBlockTypeMute
+	userBlocks, err := s.Store.ListUserBlocks(ctx, &store.FindUserBlock{
+		BlockedUserID: &memo.CreatorID,
+		Type:          &muteType,
+	})
+	if err != nil {
+		slog.Warn("Failed to list muters of the event", slog.Any("err", err))
+		return
+	}
+	for _, userBlock := range userBlocks {
+		e.memoMuterIDs = append(e.memoMuterIDs, userBlock.UserID)
+	}
+	if memo.Visibility == store.Followers {
+		userFollowings, err := s.Store.ListUserFollowings(ctx, &store.FindUserFollowing{FollowingUserID: &memo.CreatorID})
+		if err != nil {
+			slog.Warn("Failed to list followers of the event", slog.Any("err", err))
+			return
+		}
+		for _, userFollowing := range userFollowings {
+			e.memoFollowerIDs = append(e.memoFollowerIDs, userFollowing.UserID)
+		}
+	}
+	s.eventBus.Publish(e)
+}
+
+// publishInboxEvent publishes the event of the inbox to its receiver.
+func (s *APIV1Service) publishInboxEvent(eventType v1pb.Event_Type, inbox *store.Inbox) {
+	if s.eventBus == nil {
+		return
+	}
+	s.eventBus.Publish(&event{
+		message: &v1pb.Event{
+			Type:       eventType,
+			CreateTime: timestamppb.New(time.Now()),
+			Inbox:      convertInboxFromStore(inbox),
+		},
+		receiverID: inbox.ReceiverID,
+	})
+}
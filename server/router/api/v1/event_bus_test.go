@@ -0,0 +1,114 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// receiveTestingEvents returns the events delivered to the subscriber so far.
+func receiveTestingEvents(subscriber *eventSubscriber) []*v1pb.Event {
+	events := []*v1pb.Event{}
+	for {
+		select {
+		case e, ok := <-subscriber.events:
+			if !ok {
+				return events
+			}
+			events = append(events, e)
+		default:
+			return events
+		}
+	}
+}
+
+func TestEventBusMemoVisibility(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin := createTestingUser(ctx, t, s, "admin", store.RoleHost)
+	creator := createTestingUser(ctx, t, s, "creator", store.RoleUser)
+	follower := createTestingUser(ctx, t, s, "follower", store.RoleUser)
+	shared := createTestingUser(ctx, t, s, "shared", store.RoleUser)
+	member := createTestingUser(ctx, t, s, "member", store.RoleUser)
+	muter := createTestingUser(ctx, t, s, "muter", store.RoleUser)
+	stranger := createTestingUser(ctx, t, s, "stranger", store.RoleUser)
+	require.NoError(t, s.Store.FollowUser(ctx, &store.UserFollowing{UserID: follower.ID, FollowingUserID: creator.ID}))
+	require.NoError(t, s.Store.FollowUser(ctx, &store.UserFollowing{UserID: muter.ID, FollowingUserID: creator.ID}))
+	_, err := s.Store.UpsertUserBlock(ctx, &store.UserBlock{UserID: muter.ID, BlockedUserID: creator.ID, Type: store.UserBlockTypeMute})
+	require.NoError(t, err)
+	group, err := s.CreateGroup(withTestingUser(ctx, admin), &v1pb.CreateGroupRequest{Group: &v1pb.Group{
+		Title:   "team",
+		Members: []string{getUserPrincipal(member)},
+	}})
+	require.NoError(t, err)
+
+	subscribers := map[string]*eventSubscriber{}
+	for _, user := range []*store.User{creator, follower, shared, member, muter, stranger} {
+		subscribers[user.Username] = s.eventBus.Subscribe(user, []v1pb.Event_Type{v1pb.Event_MEMO_CREATED})
+	}
+	anonymous := s.eventBus.Subscribe(nil, nil)
+
+	creatorCtx := withTestingUser(ctx, creator)
+	for _, memo := range []*v1pb.Memo{
+		{Content: "private", Visibility: v1pb.Visibility_PRIVATE},
+		{Content: "followers", Visibility: v1pb.Visibility_FOLLOWERS},
+		{Content: "shared", Visibility: v1pb.Visibility_PRIVATE, Acl: []*v1pb.MemoACL{
+			{Principal: getUserPrincipal(shared), Role: v1pb.MemoACL_READER},
+			{Principal: group.Name, Role: v1pb.MemoACL_READER},
+		}},
+		{Content: "public", Visibility: v1pb.Visibility_PUBLIC},
+	} {
+		_, err := s.CreateMemo(creatorCtx, &v1pb.CreateMemoRequest{Memo: memo})
+		require.NoError(t, err)
+	}
+
+	// The events are delivered to the users who see the memos in ListMemos.
+	expected := map[string][]string{
+		"creator":  {"private", "followers", "shared", "public"},
+		"follower": {"followers", "public"},
+		"shared":   {"shared", "public"},
+		"member":   {"shared", "public"},
+		"muter":    {},
+		"stranger": {"public"},
+	}
+	for username, contents := range expected {
+		received := []string{}
+		for _, e := range receiveTestingEvents(subscribers[username]) {
+			received = append(received, e.Memo.Content)
+		}
+		require.Equal(t, contents, received, username)
+	}
+	events := receiveTestingEvents(anonymous)
+	require.Equal(t, 1, len(events))
+	require.Equal(t, "public", events[0].Memo.Content)
+}
+
+func TestEventBusDropsSlowSubscriber(t *testing.T) {
+	bus := newEventBus()
+	user := &store.User{ID: 1}
+	slow := bus.Subscribe(user, nil)
+	fast := bus.Subscribe(user, nil)
+	e := &event{
+		message: &v1pb.Event{Type: v1pb.Event_MEMO_CREATED},
+		memo:    &store.Memo{CreatorID: 2, Visibility: store.Public},
+	}
+
+	// The fast subscriber keeps up, the slow one never reads and falls behind.
+	for i := 0; i < eventSubscriberBufferSize; i++ {
+		bus.Publish(e)
+		require.Equal(t, 1, len(receiveTestingEvents(fast)))
+	}
+	bus.Publish(e)
+	require.Equal(t, 1, len(receiveTestingEvents(fast)))
+
+	// The slow subscriber gets the buffered events and then its channel is closed, so the watcher reconnects.
+	require.Equal(t, eventSubscriberBufferSize, len(receiveTestingEvents(slow)))
+	_, ok := <-slow.events
+	require.False(t, ok)
+	require.False(t, bus.subscribers[slow])
+	require.True(t, bus.subscribers[fast])
+}
@@ -0,0 +1,120 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// eventKeepaliveInterval is the interval of the keepalive comments of the Server-Sent Events,
+// so proxies don't close the idle connections.
+const eventKeepaliveInterval = 30 * time.Second
+
+func (s *APIV1Service) WatchEvents(request *v1pb.WatchEventsRequest, stream grpc.ServerStreamingServer[v1pb.Event]) error {
+	return s.watchEvents(stream.Context(), request.Types, stream.Send, nil)
+}
+
+// watchEvents sends the events the current user can receive until the context is done.
+// The keepalive, if any, is called when no event is sent for a while.
+func (s *APIV1Service) watchEvents(ctx context.Context, types []v1pb.Event_Type, send func(*v1pb.Event) error, keepalive func() error) error {
+	if s.eventBus == nil {
+		return status.Errorf(codes.Unavailable, "events are not available")
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+
+	subscriber := s.eventBus.Subscribe(user, types)
+	defer s.eventBus.Unsubscribe(subscriber)
+	ticker := time.NewTicker(eventKeepaliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case message, ok := <-subscriber.events:
+			if !ok {
+				return status.Errorf(codes.ResourceExhausted, "the watcher fell behind the events, reconnect and reload")
+			}
+			if err := send(message); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if keepalive == nil {
+				continue
+			}
+			if err := keepalive(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// watchEventsSSE streams the events of WatchEvents as Server-Sent Events.
+// The types of the events to watch are given by the comma separated types query parameter.
+func (s *APIV1Service) watchEventsSSE(c echo.Context) error {
+	// Authenticate the request the same way as the gRPC requests.
+	md := metadata.MD{}
+	if authorization := c.Request().Header.Get(echo.HeaderAuthorization); authorization != "" {
+		md.Set("authorization", authorization)
+	}
+	if cookie := c.Request().Header.Get("Cookie"); cookie != "" {
+		md.Set("cookie", cookie)
+	}
+	ctx, err := NewGRPCAuthInterceptor(s.Store, s.Secret).authenticateContext(metadata.NewIncomingContext(c.Request().Context(), md), v1pb.EventService_WatchEvents_FullMethodName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	types := []v1pb.Event_Type{}
+	for _, value := range c.QueryParams()["types"] {
+		for _, name := range strings.Split(value, ",") {
+			eventType, ok := v1pb.Event_Type_value[strings.TrimSpace(name)]
+			if !ok {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid event type %q", name))
+			}
+			types = append(types, v1pb.Event_Type(eventType))
+		}
+	}
+
+	response := c.Response()
+	response.Header().Set(echo.HeaderContentType, "text/event-stream")
+	response.Header().Set(echo.HeaderCacheControl, "no-cache")
+	response.Header().Set(echo.HeaderConnection, "keep-alive")
+	response.WriteHeader(http.StatusOK)
+	response.Flush()
+
+	err = s.watchEvents(ctx, types, func(message *v1pb.Event) error {
+		data, err := protojson.Marshal(message)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(response, "event: %s\ndata: %s\n\n", message.Type.String(), data); err != nil {
+			return err
+		}
+		response.Flush()
+		return nil
+	}, func() error {
+		if _, err := fmt.Fprint(response, ": keepalive\n\n"); err != nil {
+			return err
+		}
+		response.Flush()
+		return nil
+	})
+	// The client reconnects when the stream is closed, and reloads what it missed.
+	if status.Code(err) == codes.ResourceExhausted {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,163 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListFollowRequests(ctx context.Context, _ *v1pb.ListFollowRequestsRequest) (*v1pb.ListFollowRequestsResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	followRequests, err := s.Store.ListFollowRequests(ctx, &store.FindFollowRequest{
+		UserID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list follow requests: %v", err)
+	}
+	response := &v1pb.ListFollowRequestsResponse{
+		FollowRequests: []*v1pb.FollowRequest{},
+	}
+	for _, followRequest := range followRequests {
+		response.FollowRequests = append(response.FollowRequests, convertFollowRequestFromStore(followRequest))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) AcceptFollowRequest(ctx context.Context, request *v1pb.AcceptFollowRequestRequest) (*emptypb.Empty, error) {
+	followRequest, err := s.getFollowRequestOfCurrentUser(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// The requester follows the user only from now on.
+	if err := s.Store.WithTx(ctx, func(tx *store.Store) error {
+		if err := tx.FollowUser(ctx, &store.UserFollowing{
+			UserID:          followRequest.RequesterID,
+			FollowingUserID: followRequest.UserID,
+		}); err != nil {
+			return errors.Wrap(err, "failed to follow user")
+		}
+		return tx.DeleteFollowRequest(ctx, &store.DeleteFollowRequest{ID: followRequest.ID})
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to accept follow request: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) RejectFollowRequest(ctx context.Context, request *v1pb.RejectFollowRequestRequest) (*emptypb.Empty, error) {
+	followRequest, err := s.getFollowRequestOfCurrentUser(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Store.DeleteFollowRequest(ctx, &store.DeleteFollowRequest{ID: followRequest.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete follow request: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getFollowRequestOfCurrentUser returns the follow request by its name, only the requested user can handle it.
+func (s *APIV1Service) getFollowRequestOfCurrentUser(ctx context.Context, name string) (*store.FollowRequest, error) {
+	id, err := ExtractFollowRequestIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid follow request name: %v", err)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	followRequest, err := s.Store.GetFollowRequest(ctx, &store.FindFollowRequest{ID: &id})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get follow request: %v", err)
+	}
+	if followRequest == nil {
+		return nil, status.Errorf(codes.NotFound, "follow request not found")
+	}
+	if followRequest.UserID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return followRequest, nil
+}
+
+// isFollowApprovalRequired returns whether the user approves their followers.
+func (s *APIV1Service) isFollowApprovalRequired(ctx context.Context, userID int32) (bool, error) {
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSettingKey_APPROVE_FOLLOWERS,
+	})
+	if err != nil {
+		return false, err
+	}
+	return userSetting.GetApproveFollowers(), nil
+}
+
+// requestToFollow requests the user to approve the follower, the request is sent to their inbox once.
+func (s *APIV1Service) requestToFollow(ctx context.Context, requester, user *store.User) error {
+	followRequest, err := s.Store.GetFollowRequest(ctx, &store.FindFollowRequest{
+		RequesterID: &requester.ID,
+		UserID:      &user.ID,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get follow request: %v", err)
+	}
+	if followRequest != nil {
+		return nil
+	}
+
+	var inbox *store.Inbox
+	if err := s.Store.WithTx(ctx, func(tx *store.Store) error {
+		followRequest, err := tx.CreateFollowRequest(ctx, &store.FollowRequest{
+			RequesterID: requester.ID,
+			UserID:      user.ID,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create follow request")
+		}
+		inbox, err = tx.CreateInbox(ctx, &store.Inbox{
+			SenderID:   requester.ID,
+			ReceiverID: user.ID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type:            storepb.InboxMessage_FOLLOW_REQUEST,
+				FollowRequestId: &followRequest.ID,
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create inbox")
+		}
+		return nil
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to request to follow: %v", err)
+	}
+	s.publishInboxEvent(v1pb.Event_INBOX_CREATED, inbox)
+	return nil
+}
+
+func convertFollowRequestFromStore(followRequest *store.FollowRequest) *v1pb.FollowRequest {
+	return &v1pb.FollowRequest{
+		Name:       fmt.Sprintf("%s%d", FollowRequestNamePrefix, followRequest.ID),
+		Requester:  fmt.Sprintf("%s%d", UserNamePrefix, followRequest.RequesterID),
+		CreateTime: timestamppb.New(time.Unix(followRequest.CreatedTs, 0)),
+	}
+}
@@ -0,0 +1,246 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListGroups(ctx context.Context, _ *v1pb.ListGroupsRequest) (*v1pb.ListGroupsResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	userGroups, err := s.Store.ListUserGroups(ctx, &store.FindUserGroup{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list groups: %v", err)
+	}
+	response := &v1pb.ListGroupsResponse{
+		Groups: []*v1pb.Group{},
+	}
+	for _, userGroup := range userGroups {
+		group, err := s.convertGroupFromStore(ctx, userGroup)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert group: %v", err)
+		}
+		response.Groups = append(response.Groups, group)
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetGroup(ctx context.Context, request *v1pb.GetGroupRequest) (*v1pb.Group, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	userGroup, err := s.getUserGroupByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	group, err := s.convertGroupFromStore(ctx, userGroup)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert group: %v", err)
+	}
+	return group, nil
+}
+
+func (s *APIV1Service) CreateGroup(ctx context.Context, request *v1pb.CreateGroupRequest) (*v1pb.Group, error) {
+	user, err := s.getGroupManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if request.Group == nil || request.Group.Title == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "group title is required")
+	}
+	memberIDList, err := s.convertGroupMembersToStore(ctx, request.Group.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	var userGroup *store.UserGroup
+	if err := s.Store.WithTx(ctx, func(tx *store.Store) error {
+		var err error
+		userGroup, err = tx.CreateUserGroup(ctx, &store.UserGroup{
+			CreatorID: user.ID,
+			Name:      request.Group.Title,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create user group")
+		}
+		return tx.SetUserGroupMembers(ctx, userGroup.ID, memberIDList)
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create group: %v", err)
+	}
+	group, err := s.convertGroupFromStore(ctx, userGroup)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert group: %v", err)
+	}
+	return group, nil
+}
+
+func (s *APIV1Service) UpdateGroup(ctx context.Context, request *v1pb.UpdateGroupRequest) (*v1pb.Group, error) {
+	if _, err := s.getGroupManager(ctx); err != nil {
+		return nil, err
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update_mask is required")
+	}
+	if request.Group == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "group is required")
+	}
+	userGroup, err := s.getUserGroupByName(ctx, request.Group.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	update := &store.UpdateUserGroup{
+		ID: userGroup.ID,
+	}
+	var memberIDList []int32
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "title":
+			if request.Group.Title == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "group title is required")
+			}
+			update.Name = &request.Group.Title
+		case "members":
+			memberIDList, err = s.convertGroupMembersToStore(ctx, request.Group.Members)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+
+	if err := s.Store.WithTx(ctx, func(tx *store.Store) error {
+		if update.Name != nil {
+			if userGroup, err = tx.UpdateUserGroup(ctx, update); err != nil {
+				return errors.Wrap(err, "failed to update user group")
+			}
+		}
+		if slices.Contains(request.UpdateMask.Paths, "members") {
+			return tx.SetUserGroupMembers(ctx, userGroup.ID, memberIDList)
+		}
+		return nil
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update group: %v", err)
+	}
+	group, err := s.convertGroupFromStore(ctx, userGroup)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert group: %v", err)
+	}
+	return group, nil
+}
+
+func (s *APIV1Service) DeleteGroup(ctx context.Context, request *v1pb.DeleteGroupRequest) (*emptypb.Empty, error) {
+	if _, err := s.getGroupManager(ctx); err != nil {
+		return nil, err
+	}
+	userGroup, err := s.getUserGroupByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// The memos shared with the group are no longer shared with its members.
+	principal := getGroupPrincipal(userGroup.ID)
+	if err := s.Store.WithTx(ctx, func(tx *store.Store) error {
+		if err := tx.DeleteMemoACL(ctx, &store.DeleteMemoACL{Principal: &principal}); err != nil {
+			return errors.Wrap(err, "failed to delete memo acl")
+		}
+		return tx.DeleteUserGroup(ctx, &store.DeleteUserGroup{ID: userGroup.ID})
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete group: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getGroupManager returns the current user, who has to be an admin to manage the groups.
+func (s *APIV1Service) getGroupManager(ctx context.Context) (*store.User, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return user, nil
+}
+
+func (s *APIV1Service) getUserGroupByName(ctx context.Context, name string) (*store.UserGroup, error) {
+	groupID, err := ExtractGroupIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid group name: %v", err)
+	}
+	userGroup, err := s.Store.GetUserGroup(ctx, &store.FindUserGroup{ID: &groupID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get group: %v", err)
+	}
+	if userGroup == nil {
+		return nil, status.Errorf(codes.NotFound, "group not found")
+	}
+	return userGroup, nil
+}
+
+// convertGroupMembersToStore validates the members of the group, the duplicated members are merged.
+func (s *APIV1Service) convertGroupMembersToStore(ctx context.Context, members []string) ([]int32, error) {
+	memberIDList := []int32{}
+	for _, member := range members {
+		userID, err := ExtractUserIDFromName(member)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid member: %v", err)
+		}
+		if slices.Contains(memberIDList, userID) {
+			continue
+		}
+		user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		}
+		if user == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "member %s not found", member)
+		}
+		memberIDList = append(memberIDList, userID)
+	}
+	return memberIDList, nil
+}
+
+func (s *APIV1Service) convertGroupFromStore(ctx context.Context, userGroup *store.UserGroup) (*v1pb.Group, error) {
+	userGroupMembers, err := s.Store.ListUserGroupMembers(ctx, &store.FindUserGroupMember{GroupID: &userGroup.ID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list user group members")
+	}
+	group := &v1pb.Group{
+		Name:       getGroupPrincipal(userGroup.ID),
+		Title:      userGroup.Name,
+		Members:    []string{},
+		Creator:    fmt.Sprintf("%s%d", UserNamePrefix, userGroup.CreatorID),
+		CreateTime: timestamppb.New(time.Unix(userGroup.CreatedTs, 0)),
+	}
+	for _, userGroupMember := range userGroupMembers {
+		group.Members = append(group.Members, fmt.Sprintf("%s%d", UserNamePrefix, userGroupMember.UserID))
+	}
+	return group, nil
+}
@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestShareMemoWithGroup(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin := createTestingUser(ctx, t, s, "admin", store.RoleHost)
+	creator := createTestingUser(ctx, t, s, "creator", store.RoleUser)
+	member := createTestingUser(ctx, t, s, "member", store.RoleUser)
+	other := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	adminCtx := withTestingUser(ctx, admin)
+	creatorCtx := withTestingUser(ctx, creator)
+	memberCtx := withTestingUser(ctx, member)
+	otherCtx := withTestingUser(ctx, other)
+
+	// Only admins manage the groups.
+	_, err := s.CreateGroup(memberCtx, &v1pb.CreateGroupRequest{Group: &v1pb.Group{Title: "team"}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.CreateGroup(adminCtx, &v1pb.CreateGroupRequest{Group: &v1pb.Group{Title: "team", Members: []string{"users/999"}}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	group, err := s.CreateGroup(adminCtx, &v1pb.CreateGroupRequest{Group: &v1pb.Group{
+		Title:   "team",
+		Members: []string{getUserPrincipal(member), getUserPrincipal(member)},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, []string{getUserPrincipal(member)}, group.Members)
+
+	_, err = s.CreateMemo(creatorCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{
+		Content:    "shared",
+		Visibility: v1pb.Visibility_PRIVATE,
+		Acl:        []*v1pb.MemoACL{{Principal: "groups/999", Role: v1pb.MemoACL_READER}},
+	}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	memo, err := s.CreateMemo(creatorCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{
+		Content:    "shared",
+		Visibility: v1pb.Visibility_PRIVATE,
+		Acl:        []*v1pb.MemoACL{{Principal: group.Name, Role: v1pb.MemoACL_EDITOR}},
+	}})
+	require.NoError(t, err)
+
+	// The members of the group read and edit the memo, the other users don't.
+	_, err = s.GetMemo(memberCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	_, err = s.GetMemo(otherCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	response, err := s.ListMemos(memberCtx, &v1pb.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(response.Memos))
+	require.Equal(t, memo.Name, response.Memos[0].Name)
+	updatedMemo, err := s.UpdateMemo(memberCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: "edited by member"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "edited by member", updatedMemo.Content)
+
+	// The users removed from the group lose the access.
+	_, err = s.UpdateGroup(adminCtx, &v1pb.UpdateGroupRequest{
+		Group:      &v1pb.Group{Name: group.Name, Members: []string{getUserPrincipal(other)}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"members"}},
+	})
+	require.NoError(t, err)
+	_, err = s.GetMemo(memberCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.GetMemo(otherCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+
+	// Deleting the group removes it from the ACL of the memo.
+	_, err = s.DeleteGroup(adminCtx, &v1pb.DeleteGroupRequest{Name: group.Name})
+	require.NoError(t, err)
+	_, err = s.GetMemo(otherCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	memo, err = s.GetMemo(creatorCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Empty(t, memo.Acl)
+}
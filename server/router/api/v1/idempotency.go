@@ -0,0 +1,136 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// IdempotencyKeyHeader is the gRPC metadata, or the HTTP header, of the idempotency key of a create request.
+	IdempotencyKeyHeader = "idempotency-key"
+	// maxIdempotencyKeyLength is the maximum length of an idempotency key.
+	maxIdempotencyKeyLength = 256
+)
+
+// getIdempotencyKey returns the idempotency key of the request, it's empty if the request has none.
+func getIdempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(IdempotencyKeyHeader)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// recordIdempotencyKey records the name created by the request, in the transaction that creates it.
+type recordIdempotencyKey func(tx *APIV1Service, name string) error
+
+// runIdempotently runs the create request once per idempotency key of the current user.
+// The retries of the request with the same key return what the first one created, loaded by its name with get.
+// The create calls record with the created name in its transaction, so the key and the creation commit together.
+func runIdempotently[T any](ctx context.Context, s *APIV1Service, method string, create func(record recordIdempotencyKey) (T, error), get func(name string) (T, error)) (T, error) {
+	var empty T
+	noRecord := func(*APIV1Service, string) error { return nil }
+	key := getIdempotencyKey(ctx)
+	if key == "" {
+		return create(noRecord)
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		return empty, status.Errorf(codes.InvalidArgument, "idempotency key too long (max %d characters)", maxIdempotencyKeyLength)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return empty, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return create(noRecord)
+	}
+
+	idempotencyKey, err := s.reserveIdempotencyKey(ctx, user.ID, method, key)
+	if err != nil {
+		return empty, err
+	}
+	if idempotencyKey.Name != "" {
+		return get(idempotencyKey.Name)
+	}
+
+	result, err := create(func(tx *APIV1Service, name string) error {
+		if err := tx.Store.UpdateIdempotencyKey(ctx, &store.UpdateIdempotencyKey{
+			ID:   idempotencyKey.ID,
+			Name: &name,
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to update idempotency key: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		// Release the key so the retry creates again, it is reclaimed after the lease otherwise.
+		if err := s.Store.DeleteIdempotencyKey(ctx, &store.DeleteIdempotencyKey{ID: &idempotencyKey.ID}); err != nil {
+			slog.Warn("Failed to delete idempotency key", slog.Any("err", err))
+		}
+		return empty, err
+	}
+	return result, nil
+}
+
+func isIdempotencyKeyExpired(idempotencyKey *store.IdempotencyKey) bool {
+	ttl := store.IdempotencyKeyTTL
+	if idempotencyKey.Name == "" {
+		ttl = store.IdempotencyKeyLease
+	}
+	return time.Unix(idempotencyKey.CreatedTs, 0).Add(ttl).Before(time.Now())
+}
+
+// reserveIdempotencyKey reserves the key for the request, or returns the key of the previous request
+// with its created name. The reservation is unique, so the concurrent retries don't create twice.
+func (s *APIV1Service) reserveIdempotencyKey(ctx context.Context, userID int32, method, key string) (*store.IdempotencyKey, error) {
+	find := &store.FindIdempotencyKey{
+		CreatorID: &userID,
+		Method:    &method,
+		Key:       &key,
+	}
+	idempotencyKey, err := s.Store.GetIdempotencyKey(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get idempotency key: %v", err)
+	}
+	// The expired key is replaced, as if the runner had already deleted it. So is the reservation
+	// left without the name after its lease, its request failed without creating anything.
+	if idempotencyKey != nil && isIdempotencyKeyExpired(idempotencyKey) {
+		if err := s.Store.DeleteIdempotencyKey(ctx, &store.DeleteIdempotencyKey{ID: &idempotencyKey.ID}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to delete idempotency key: %v", err)
+		}
+		idempotencyKey = nil
+	}
+	if idempotencyKey == nil {
+		created, err := s.Store.CreateIdempotencyKey(ctx, &store.IdempotencyKey{
+			CreatorID: userID,
+			Key:       key,
+			Method:    method,
+		})
+		if err == nil {
+			return created, nil
+		}
+		// The key may be reserved by a concurrent request in the meantime.
+		idempotencyKey, err = s.Store.GetIdempotencyKey(ctx, find)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get idempotency key: %v", err)
+		}
+		if idempotencyKey == nil {
+			return nil, status.Errorf(codes.Internal, "failed to create idempotency key")
+		}
+	}
+	if idempotencyKey.Name == "" {
+		return nil, status.Errorf(codes.Aborted, "a request with the same idempotency key is in progress")
+	}
+	return idempotencyKey, nil
+}
@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestCreateMemoIdempotently(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	userCtx := metadata.NewIncomingContext(withTestingUser(ctx, user), metadata.Pairs(IdempotencyKeyHeader, "key"))
+
+	// The retries return the memo created by the first request, its name is recorded with it.
+	memo, err := s.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "first"}})
+	require.NoError(t, err)
+	retried, err := s.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "first"}})
+	require.NoError(t, err)
+	require.Equal(t, memo.Name, retried.Name)
+	idempotencyKeys, err := s.Store.ListIdempotencyKeys(ctx, &store.FindIdempotencyKey{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(idempotencyKeys))
+	require.Equal(t, memo.Name, idempotencyKeys[0].Name)
+
+	// The key reserved by a request in progress aborts the retries.
+	_, err = s.Store.CreateIdempotencyKey(ctx, &store.IdempotencyKey{
+		CreatorID: user.ID,
+		Key:       "in-progress",
+		Method:    v1pb.MemoService_CreateMemo_FullMethodName,
+	})
+	require.NoError(t, err)
+	inProgressCtx := metadata.NewIncomingContext(withTestingUser(ctx, user), metadata.Pairs(IdempotencyKeyHeader, "in-progress"))
+	_, err = s.CreateMemo(inProgressCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "second"}})
+	require.Equal(t, codes.Aborted, status.Code(err))
+}
+
+func TestIsIdempotencyKeyExpired(t *testing.T) {
+	now := time.Now()
+	for _, testCase := range []struct {
+		createdTs time.Time
+		name      string
+		expired   bool
+	}{
+		{createdTs: now, name: "", expired: false},
+		{createdTs: now.Add(-2 * store.IdempotencyKeyLease), name: "", expired: true},
+		{createdTs: now.Add(-2 * store.IdempotencyKeyLease), name: "memos/test", expired: false},
+		{createdTs: now.Add(-2 * store.IdempotencyKeyTTL), name: "memos/test", expired: true},
+	} {
+		idempotencyKey := &store.IdempotencyKey{CreatedTs: testCase.createdTs.Unix(), Name: testCase.name}
+		require.Equal(t, testCase.expired, isIdempotencyKeyExpired(idempotencyKey))
+	}
+}
@@ -21,6 +21,7 @@ func (s *APIV1Service) ListInboxes(ctx context.Context, request *v1pb.ListInboxe
 	}
 
 	var limit, offset int
+	var cursor *store.Cursor
 	if request.PageToken != "" {
 		var pageToken v1pb.PageToken
 		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
@@ -28,6 +29,7 @@ func (s *APIV1Service) ListInboxes(ctx context.Context, request *v1pb.ListInboxe
 		}
 		limit = int(pageToken.Limit)
 		offset = int(pageToken.Offset)
+		cursor = convertCursorFromPageToken(&pageToken)
 	} else {
 		limit = int(request.PageSize)
 	}
@@ -40,6 +42,7 @@ func (s *APIV1Service) ListInboxes(ctx context.Context, request *v1pb.ListInboxe
 		ReceiverID: &user.ID,
 		Limit:      &limitPlusOne,
 		Offset:     &offset,
+		Cursor:     cursor,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list inbox: %v", err)
@@ -49,7 +52,11 @@ func (s *APIV1Service) ListInboxes(ctx context.Context, request *v1pb.ListInboxe
 	nextPageToken := ""
 	if len(inboxes) == limitPlusOne {
 		inboxes = inboxes[:limit]
-		nextPageToken, err = getPageToken(limit, offset+limit)
+		lastInbox := inboxes[len(inboxes)-1]
+		nextPageToken, err = getCursorPageToken(limit, &store.Cursor{
+			ID: lastInbox.ID,
+			Ts: lastInbox.CreatedTs,
+		})
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
 		}
@@ -94,6 +101,7 @@ func (s *APIV1Service) UpdateInbox(ctx context.Context, request *v1pb.UpdateInbo
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update inbox: %v", err)
 	}
+	s.publishInboxEvent(v1pb.Event_INBOX_UPDATED, inbox)
 
 	return convertInboxFromStore(inbox), nil
 }
@@ -104,16 +112,25 @@ func (s *APIV1Service) DeleteInbox(ctx context.Context, request *v1pb.DeleteInbo
 		return nil, status.Errorf(codes.InvalidArgument, "invalid inbox name: %v", err)
 	}
 
+	inboxes, err := s.Store.ListInboxes(ctx, &store.FindInbox{
+		ID: &inboxID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list inboxes: %v", err)
+	}
 	if err := s.Store.DeleteInbox(ctx, &store.DeleteInbox{
 		ID: inboxID,
 	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update inbox: %v", err)
 	}
+	for _, inbox := range inboxes {
+		s.publishInboxEvent(v1pb.Event_INBOX_DELETED, inbox)
+	}
 	return &emptypb.Empty{}, nil
 }
 
 func convertInboxFromStore(inbox *store.Inbox) *v1pb.Inbox {
-	return &v1pb.Inbox{
+	inboxMessage := &v1pb.Inbox{
 		Name:       fmt.Sprintf("%s%d", InboxNamePrefix, inbox.ID),
 		Sender:     fmt.Sprintf("%s%d", UserNamePrefix, inbox.SenderID),
 		Receiver:   fmt.Sprintf("%s%d", UserNamePrefix, inbox.ReceiverID),
@@ -122,6 +139,15 @@ func convertInboxFromStore(inbox *store.Inbox) *v1pb.Inbox {
 		Type:       v1pb.Inbox_Type(inbox.Message.Type),
 		ActivityId: inbox.Message.ActivityId,
 	}
+	if inbox.Message.ChannelId != nil && inbox.Message.MessageId != nil {
+		message := getMessageName(*inbox.Message.ChannelId, *inbox.Message.MessageId)
+		inboxMessage.Message = &message
+	}
+	if inbox.Message.FollowRequestId != nil {
+		followRequest := fmt.Sprintf("%s%d", FollowRequestNamePrefix, *inbox.Message.FollowRequestId)
+		inboxMessage.FollowRequest = &followRequest
+	}
+	return inboxMessage
 }
 
 func convertInboxStatusFromStore(status store.InboxStatus) v1pb.Inbox_Status {
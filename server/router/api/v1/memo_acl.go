@@ -0,0 +1,212 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// memoEditorUpdatePaths are the fields of a memo the editors of its ACL can update.
+// The visibility, the ACL and the state are left to the creator.
+var memoEditorUpdatePaths = map[string]bool{
+	"content":   true,
+	"location":  true,
+	"resources": true,
+	"relations": true,
+}
+
+// getUserPrincipal returns the principal of the user in memo ACLs.
+func getUserPrincipal(user *store.User) string {
+	return fmt.Sprintf("%s%d", UserNamePrefix, user.ID)
+}
+
+// getGroupPrincipal returns the principal of the group in memo ACLs.
+func getGroupPrincipal(groupID int32) string {
+	return fmt.Sprintf("%s%d", GroupNamePrefix, groupID)
+}
+
+// getUserPrincipals returns the principals the user is matched by in memo ACLs, the user and the groups of the user.
+func (s *APIV1Service) getUserPrincipals(ctx context.Context, user *store.User) ([]string, error) {
+	principals := []string{getUserPrincipal(user)}
+	userGroups, err := s.Store.ListUserGroups(ctx, &store.FindUserGroup{MemberID: &user.ID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list user groups")
+	}
+	for _, userGroup := range userGroups {
+		principals = append(principals, getGroupPrincipal(userGroup.ID))
+	}
+	return principals, nil
+}
+
+// getMemoACLRole returns the role granted to the user by the ACL of the memo, it's empty if the memo is not shared with the user.
+// The user shared with the memo both directly and by groups gets the strongest of the roles.
+func (s *APIV1Service) getMemoACLRole(ctx context.Context, user *store.User, memoID int32) (store.MemoACLRole, error) {
+	if user == nil {
+		return "", nil
+	}
+	principals, err := s.getUserPrincipals(ctx, user)
+	if err != nil {
+		return "", err
+	}
+	memoACLs, err := s.Store.ListMemoACLs(ctx, &store.FindMemoACL{
+		MemoID:        &memoID,
+		PrincipalList: principals,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to list memo acl")
+	}
+	var role store.MemoACLRole
+	for _, memoACL := range memoACLs {
+		if memoACL.Role == store.MemoACLRoleEditor {
+			return memoACL.Role, nil
+		}
+		role = memoACL.Role
+	}
+	return role, nil
+}
+
+// canReadMemo returns whether the user can read the memo by its visibility, the following of its creator or its ACL.
+func (s *APIV1Service) canReadMemo(ctx context.Context, user *store.User, memo *store.Memo) (bool, error) {
+	if canReadMemoByVisibility(user, memo) {
+		return true, nil
+	}
+	if user == nil {
+		return false, nil
+	}
+	if memo.Visibility == store.Followers {
+		isFollowing, err := s.Store.IsFollowingUser(ctx, &store.UserFollowing{
+			UserID:          user.ID,
+			FollowingUserID: memo.CreatorID,
+		})
+		if err != nil {
+			return false, errors.Wrap(err, "failed to check following status")
+		}
+		if isFollowing {
+			return true, nil
+		}
+	}
+	role, err := s.getMemoACLRole(ctx, user, memo.ID)
+	if err != nil {
+		return false, err
+	}
+	return role != "", nil
+}
+
+// canReadMemoByVisibility returns whether the user can read the memo by its visibility, regardless of its ACL.
+// The FOLLOWERS memos are only readable by their creator here, the followers are checked by canReadMemo.
+func canReadMemoByVisibility(user *store.User, memo *store.Memo) bool {
+	if memo.Visibility == store.Public {
+		return true
+	}
+	if user == nil {
+		return false
+	}
+	return memo.Visibility == store.Protected || memo.CreatorID == user.ID
+}
+
+// convertMemoACLToStore validates the ACL of the memo, the entries of the same principal are merged with the last role.
+func (s *APIV1Service) convertMemoACLToStore(ctx context.Context, memo *store.Memo, acl []*v1pb.MemoACL) ([]*store.MemoACL, error) {
+	memoACLs := []*store.MemoACL{}
+	memoACLMap := map[string]*store.MemoACL{}
+	for _, entry := range acl {
+		principal, err := s.convertMemoACLPrincipalToStore(ctx, entry.Principal)
+		if err != nil {
+			return nil, err
+		}
+		// The creator always has full access to the memo.
+		if principal == fmt.Sprintf("%s%d", UserNamePrefix, memo.CreatorID) {
+			continue
+		}
+
+		role := convertMemoACLRoleToStore(entry.Role)
+		if memoACL, ok := memoACLMap[principal]; ok {
+			memoACL.Role = role
+			continue
+		}
+		memoACL := &store.MemoACL{
+			MemoID:    memo.ID,
+			Principal: principal,
+			Role:      role,
+		}
+		memoACLs = append(memoACLs, memoACL)
+		memoACLMap[principal] = memoACL
+	}
+	return memoACLs, nil
+}
+
+// convertMemoACLPrincipalToStore validates the principal of an ACL entry, which is an existing user or group.
+func (s *APIV1Service) convertMemoACLPrincipalToStore(ctx context.Context, principal string) (string, error) {
+	if strings.HasPrefix(principal, GroupNamePrefix) {
+		groupID, err := ExtractGroupIDFromName(principal)
+		if err != nil {
+			return "", status.Errorf(codes.InvalidArgument, "invalid principal: %v", err)
+		}
+		userGroup, err := s.Store.GetUserGroup(ctx, &store.FindUserGroup{ID: &groupID})
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "failed to get group: %v", err)
+		}
+		if userGroup == nil {
+			return "", status.Errorf(codes.InvalidArgument, "principal %s not found", principal)
+		}
+		return getGroupPrincipal(userGroup.ID), nil
+	}
+	userID, err := ExtractUserIDFromName(principal)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid principal: %v", err)
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return "", status.Errorf(codes.InvalidArgument, "principal %s not found", principal)
+	}
+	return getUserPrincipal(user), nil
+}
+
+// setMemoACL replaces the ACL of the memo with the one of the request.
+func (s *APIV1Service) setMemoACL(ctx context.Context, memo *store.Memo, acl []*v1pb.MemoACL) error {
+	memoACLs, err := s.convertMemoACLToStore(ctx, memo, acl)
+	if err != nil {
+		return err
+	}
+	if err := s.Store.SetMemoACL(ctx, memo.ID, memoACLs); err != nil {
+		return status.Errorf(codes.Internal, "failed to set memo acl: %v", err)
+	}
+	return nil
+}
+
+func convertMemoACLFromStore(memoACL *store.MemoACL) *v1pb.MemoACL {
+	return &v1pb.MemoACL{
+		Principal: memoACL.Principal,
+		Role:      convertMemoACLRoleFromStore(memoACL.Role),
+	}
+}
+
+func convertMemoACLRoleFromStore(role store.MemoACLRole) v1pb.MemoACL_Role {
+	switch role {
+	case store.MemoACLRoleReader:
+		return v1pb.MemoACL_READER
+	case store.MemoACLRoleEditor:
+		return v1pb.MemoACL_EDITOR
+	default:
+		return v1pb.MemoACL_ROLE_UNSPECIFIED
+	}
+}
+
+// convertMemoACLRoleToStore converts the role, an unspecified role means a reader.
+func convertMemoACLRoleToStore(role v1pb.MemoACL_Role) store.MemoACLRole {
+	switch role {
+	case v1pb.MemoACL_EDITOR:
+		return store.MemoACLRoleEditor
+	default:
+		return store.MemoACLRoleReader
+	}
+}
@@ -0,0 +1,303 @@
+package v1
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// exportArchiveChunkSize is the size of the HttpBody chunks of the memo archive stream.
+const exportArchiveChunkSize = 1 << 20
+
+// memoArchiveSidecar is the JSON sidecar of a memo in the archive, which keeps everything
+// that the markdown file can't, e.g. resources, comments, reactions, relations and location.
+type memoArchiveSidecar struct {
+	Memo     json.RawMessage   `json:"memo"`
+	Comments []json.RawMessage `json:"comments"`
+	// ResourcePaths maps the resource names to the paths of their binaries in the archive.
+	// The resources stored externally have no binary and keep their external link.
+	ResourcePaths map[string]string `json:"resourcePaths"`
+}
+
+// ExportMemoArchive streams a ZIP archive of the memos of the current user. Every memo has a markdown
+// file in the same layout as ExportMemos, a JSON sidecar and a folder of the resource binaries:
+//
+//	2024/01/20240102_150405_title_PRIVATE.md
+//	2024/01/20240102_150405_title_PRIVATE.json
+//	2024/01/20240102_150405_title_PRIVATE/{resource uid}_{filename}
+//
+// The archive is written to the stream in chunks, so it is never held in memory as a whole.
+func (s *APIV1Service) ExportMemoArchive(request *v1pb.ExportMemoArchiveRequest, stream grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	ctx := stream.Context()
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	normalRowStatus := store.Normal
+	memoFind := &store.FindMemo{
+		CreatorID:       &user.ID,
+		RowStatus:       &normalRowStatus,
+		ExcludeComments: true,
+	}
+	if err := s.buildMemoFindWithFilter(ctx, memoFind, request.Filter); err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to build find memos with filter: %v", err)
+	}
+	// The filter narrows down the memos of the current user, it can't select the memos of others.
+	if memoFind.CreatorID != nil && *memoFind.CreatorID != user.ID {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	memoFind.CreatorID = &user.ID
+	memos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+
+	buffer := bufio.NewWriterSize(&httpBodyStreamWriter{stream: stream, contentType: "application/zip"}, exportArchiveChunkSize)
+	writer := zip.NewWriter(buffer)
+	exportedPaths := map[string]bool{}
+	for chunk := range slices.Chunk(memos, exportConvertBatchSize) {
+		if err := s.writeMemoArchiveChunk(ctx, writer, chunk, exportedPaths); err != nil {
+			return err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return status.Errorf(codes.Internal, "failed to close zip writer: %v", err)
+	}
+	if err := buffer.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "failed to flush the archive: %v", err)
+	}
+	return nil
+}
+
+// writeMemoArchiveChunk writes the memos with their comments and resources to the archive.
+func (s *APIV1Service) writeMemoArchiveChunk(ctx context.Context, writer *zip.Writer, memos []*store.Memo, exportedPaths map[string]bool) error {
+	memoMessages, err := s.convertMemosFromStore(ctx, memos)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to convert memos: %v", err)
+	}
+	memoIDList := []int32{}
+	for _, memo := range memos {
+		memoIDList = append(memoIDList, memo.ID)
+	}
+
+	// Load the comments of the memos at once.
+	commentType := store.MemoRelationComment
+	commentRelations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+		RelatedMemoIDList: memoIDList,
+		Type:              &commentType,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list comment relations: %v", err)
+	}
+	commentMessagesMap := map[int32][]*v1pb.Memo{}
+	resourceMemoIDList := slices.Clone(memoIDList)
+	// parentIDMap maps the comment ids to the ids of their memos.
+	parentIDMap := map[int32]int32{}
+	if len(commentRelations) > 0 {
+		commentIDList := []int32{}
+		for _, relation := range commentRelations {
+			commentIDList = append(commentIDList, relation.MemoID)
+		}
+		normalRowStatus := store.Normal
+		comments, err := s.Store.ListMemos(ctx, &store.FindMemo{
+			IDList:    commentIDList,
+			RowStatus: &normalRowStatus,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list comments: %v", err)
+		}
+		commentMessages, err := s.convertMemosFromStore(ctx, comments)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to convert comments: %v", err)
+		}
+		for _, relation := range commentRelations {
+			parentIDMap[relation.MemoID] = relation.RelatedMemoID
+		}
+		for i, comment := range comments {
+			parentID := parentIDMap[comment.ID]
+			commentMessagesMap[parentID] = append(commentMessagesMap[parentID], commentMessages[i])
+			resourceMemoIDList = append(resourceMemoIDList, comment.ID)
+		}
+	}
+
+	// The resources of the comments are exported with the memo, the blobs are loaded one by one.
+	resources, err := s.Store.ListResources(ctx, &store.FindResource{
+		MemoIDList: resourceMemoIDList,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list resources: %v", err)
+	}
+	resourceMap := map[int32][]*store.Resource{}
+	for _, resource := range resources {
+		memoID := *resource.MemoID
+		if parentID, ok := parentIDMap[memoID]; ok {
+			memoID = parentID
+		}
+		resourceMap[memoID] = append(resourceMap[memoID], resource)
+	}
+
+	for i, memo := range memos {
+		filePath := getMemoExportPath(memo)
+		// Memos created in the same second with the same title would have the same path.
+		// The uid is added before the visibility to keep the path importable.
+		if exportedPaths[filePath] {
+			visibilitySuffix := fmt.Sprintf("_%s", memo.Visibility)
+			filePath = fmt.Sprintf("%s-%s%s", strings.TrimSuffix(filePath, visibilitySuffix), memo.UID, visibilitySuffix)
+		}
+		exportedPaths[filePath] = true
+		modified := time.Unix(memo.UpdatedTs, 0)
+
+		if err := writeMemoArchiveFile(writer, filePath+".md", modified, []byte(memo.Content)); err != nil {
+			return err
+		}
+
+		sidecar := &memoArchiveSidecar{
+			Comments:      []json.RawMessage{},
+			ResourcePaths: map[string]string{},
+		}
+		for _, comment := range commentMessagesMap[memo.ID] {
+			commentJSON, err := protojson.Marshal(comment)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to marshal comment: %v", err)
+			}
+			sidecar.Comments = append(sidecar.Comments, commentJSON)
+		}
+		for _, resource := range resourceMap[memo.ID] {
+			resourcePath := fmt.Sprintf("%s/%s_%s", filePath, resource.UID, filepath.Base(resource.Filename))
+			exported, err := s.writeResourceArchiveFile(ctx, writer, resourcePath, resource)
+			if err != nil {
+				return err
+			}
+			if exported {
+				sidecar.ResourcePaths[fmt.Sprintf("%s%s", ResourceNamePrefix, resource.UID)] = resourcePath
+			}
+		}
+
+		memoJSON, err := protojson.Marshal(memoMessages[i])
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to marshal memo: %v", err)
+		}
+		sidecar.Memo = memoJSON
+		sidecarJSON, err := json.MarshalIndent(sidecar, "", "  ")
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to marshal memo sidecar: %v", err)
+		}
+		if err := writeMemoArchiveFile(writer, filePath+".json", modified, sidecarJSON); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeMemoArchiveFile(writer *zip.Writer, name string, modified time.Time, data []byte) error {
+	file, err := writer.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modified,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to create file %s: %v", name, err)
+	}
+	if _, err := file.Write(data); err != nil {
+		return status.Errorf(codes.Internal, "failed to write file %s: %v", name, err)
+	}
+	return nil
+}
+
+// writeResourceArchiveFile copies the resource binary to the archive, it returns false if the
+// resource is stored externally, e.g. in S3, and has no binary to export.
+func (s *APIV1Service) writeResourceArchiveFile(ctx context.Context, writer *zip.Writer, name string, resource *store.Resource) (bool, error) {
+	reader, err := s.openResourceBlob(ctx, resource)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "failed to open resource %s: %v", resource.UID, err)
+	}
+	if reader == nil {
+		return false, nil
+	}
+	defer reader.Close()
+
+	file, err := writer.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: time.Unix(resource.CreatedTs, 0),
+	})
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "failed to create file %s: %v", name, err)
+	}
+	if _, err := io.Copy(file, reader); err != nil {
+		return false, status.Errorf(codes.Internal, "failed to write file %s: %v", name, err)
+	}
+	return true, nil
+}
+
+// openResourceBlob opens the binary of the resource, it returns nil if the resource is stored
+// externally, e.g. in S3. The local files are streamed instead of being read into memory.
+func (s *APIV1Service) openResourceBlob(ctx context.Context, resource *store.Resource) (io.ReadCloser, error) {
+	switch resource.StorageType {
+	case storepb.ResourceStorageType_LOCAL:
+		resourcePath := filepath.FromSlash(resource.Reference)
+		if !filepath.IsAbs(resourcePath) {
+			resourcePath = filepath.Join(s.Profile.Data, resourcePath)
+		}
+		file, err := os.Open(resourcePath)
+		if err != nil {
+			return nil, err
+		}
+		return file, nil
+	case storepb.ResourceStorageType_RESOURCE_STORAGE_TYPE_UNSPECIFIED:
+		// The blob is stored in the database, load it only for the resource being written.
+		resourceWithBlob, err := s.Store.GetResource(ctx, &store.FindResource{
+			ID:      &resource.ID,
+			GetBlob: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if resourceWithBlob == nil {
+			return nil, nil
+		}
+		return io.NopCloser(bytes.NewReader(resourceWithBlob.Blob)), nil
+	default:
+		return nil, nil
+	}
+}
+
+// httpBodyStreamWriter sends the written bytes as the HttpBody chunks of the stream.
+type httpBodyStreamWriter struct {
+	stream      grpc.ServerStreamingServer[httpbody.HttpBody]
+	contentType string
+}
+
+func (w *httpBodyStreamWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&httpbody.HttpBody{
+		ContentType: w.contentType,
+		Data:        p,
+	}); err != nil {
+		return 0, errors.Wrap(err, "failed to send chunk")
+	}
+	return len(p), nil
+}
@@ -0,0 +1,55 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestExportMemoArchive(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "test", store.RoleHost)
+	otherUser := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	memo, err := s.CreateMemo(withTestingUser(ctx, user), &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "my memo", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+	_, err = s.CreateMemo(withTestingUser(ctx, otherUser), &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "other memo", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+
+	// Anonymous users can't export.
+	err = s.ExportMemoArchive(&v1pb.ExportMemoArchiveRequest{}, &testingHTTPBodyStream{ctx: ctx})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// The filter can't select the memos of another user.
+	filter := fmt.Sprintf(`creator == "%s%d" && visibilities == ["PRIVATE"]`, UserNamePrefix, otherUser.ID)
+	err = s.ExportMemoArchive(&v1pb.ExportMemoArchiveRequest{Filter: filter}, &testingHTTPBodyStream{ctx: withTestingUser(ctx, user)})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The archive has the markdown file and the sidecar of every memo of the current user.
+	stream := &testingHTTPBodyStream{ctx: withTestingUser(ctx, user)}
+	err = s.ExportMemoArchive(&v1pb.ExportMemoArchiveRequest{}, stream)
+	require.NoError(t, err)
+	files := readTestingZip(t, stream.buffer.Bytes())
+	require.Equal(t, 2, len(files))
+	for name, content := range files {
+		require.True(t, strings.HasSuffix(name, "_PRIVATE.md") || strings.HasSuffix(name, "_PRIVATE.json"), name)
+		if strings.HasSuffix(name, ".md") {
+			require.Equal(t, "my memo", string(content))
+			continue
+		}
+		sidecar := &memoArchiveSidecar{}
+		require.NoError(t, json.Unmarshal(content, sidecar))
+		require.Contains(t, string(sidecar.Memo), memo.Name)
+		require.Empty(t, sidecar.Comments)
+		require.Empty(t, sidecar.ResourcePaths)
+	}
+}
@@ -0,0 +1,193 @@
+package v1
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/importer"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ImportMemos(ctx context.Context, request *v1pb.ImportMemosRequest) (*v1pb.ImportMemosResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	var memos []*importer.Memo
+	var fileErrors []*importer.FileError
+	if reader, err := zip.NewReader(bytes.NewReader(request.Content), int64(len(request.Content))); err == nil {
+		memos, fileErrors = importer.Parse(reader)
+	} else if bytes.Contains(request.Content[:min(len(request.Content), 1024)], []byte("<en-export")) {
+		// An ENEX file is accepted without being zipped.
+		memos, fileErrors = importer.ParseENEX("import.enex", bytes.NewReader(request.Content))
+	} else {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid zip file: %v", err)
+	}
+	return s.importMemos(ctx, user.ID, memos, fileErrors)
+}
+
+// ImportParsedMemos imports the parsed memos as the memos of the creator.
+// It's used by the import command, which runs without the API server.
+func ImportParsedMemos(ctx context.Context, stores *store.Store, creatorID int32, memos []*importer.Memo, fileErrors []*importer.FileError) (*v1pb.ImportMemosResponse, error) {
+	s := &APIV1Service{Store: stores}
+	return s.importMemos(ctx, creatorID, memos, fileErrors)
+}
+
+// importMemos creates the parsed memos one by one, a memo failed to import is reported
+// in the response errors instead of aborting the import. The references between the memos
+// are created as reference relations once all memos are created.
+func (s *APIV1Service) importMemos(ctx context.Context, creatorID int32, memos []*importer.Memo, fileErrors []*importer.FileError) (*v1pb.ImportMemosResponse, error) {
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace memo related setting: %v", err)
+	}
+	workspaceStorageSetting, err := s.Store.GetWorkspaceStorageSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace storage setting: %v", err)
+	}
+	uploadSizeLimit := int(workspaceStorageSetting.UploadSizeLimitMb) * MebiByte
+	if uploadSizeLimit == 0 {
+		uploadSizeLimit = MaxUploadBufferSizeBytes
+	}
+
+	response := &v1pb.ImportMemosResponse{
+		Memos:  []string{},
+		Errors: []*v1pb.ImportMemosResponse_Error{},
+	}
+	for _, fileError := range fileErrors {
+		response.Errors = append(response.Errors, &v1pb.ImportMemosResponse_Error{
+			Path:    fileError.Path,
+			Message: fileError.Err.Error(),
+		})
+	}
+	// createdMemoIDs maps the keys of the created memos to their ids.
+	createdMemoIDs := map[string]int32{}
+	for _, memo := range memos {
+		visibility := store.Private
+		if memo.Visibility != "" {
+			visibility = store.Visibility(memo.Visibility)
+		}
+		var importErr error
+		if workspaceMemoRelatedSetting.DisallowPublicVisibility && visibility == store.Public {
+			importErr = errors.New("public memos are disallowed")
+		} else if len(memo.Content) > int(workspaceMemoRelatedSetting.ContentLengthLimit) {
+			importErr = errors.Errorf("content too long (max %d characters)", workspaceMemoRelatedSetting.ContentLengthLimit)
+		} else {
+			var created *store.Memo
+			created, importErr = s.importMemo(ctx, creatorID, memo, visibility, uploadSizeLimit)
+			if importErr == nil {
+				if memo.Key != "" {
+					createdMemoIDs[memo.Key] = created.ID
+				}
+				response.Memos = append(response.Memos, fmt.Sprintf("%s%s", MemoNamePrefix, created.UID))
+				continue
+			}
+		}
+		response.Errors = append(response.Errors, &v1pb.ImportMemosResponse_Error{
+			Path:    memo.Path,
+			Message: importErr.Error(),
+		})
+	}
+
+	for _, memo := range memos {
+		memoID, ok := createdMemoIDs[memo.Key]
+		if !ok {
+			continue
+		}
+		for _, reference := range memo.References {
+			// The referenced memo may have failed to import, which is reported already.
+			relatedMemoID, ok := createdMemoIDs[reference]
+			if !ok {
+				continue
+			}
+			if _, err := s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
+				MemoID:        memoID,
+				RelatedMemoID: relatedMemoID,
+				Type:          store.MemoRelationReference,
+			}); err != nil {
+				response.Errors = append(response.Errors, &v1pb.ImportMemosResponse_Error{
+					Path:    memo.Path,
+					Message: fmt.Sprintf("failed to create the relation to %s: %v", reference, err),
+				})
+			}
+		}
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) importMemo(ctx context.Context, creatorID int32, memo *importer.Memo, visibility store.Visibility, uploadSizeLimit int) (*store.Memo, error) {
+	// Save the attachment blobs first, so the transaction doesn't wait for the storage.
+	resources := []*store.Resource{}
+	for _, attachment := range memo.Attachments {
+		if len(attachment.Blob) > uploadSizeLimit {
+			return nil, errors.Errorf("attachment %s exceeds the size limit", attachment.Filename)
+		}
+		resource := &store.Resource{
+			UID:       shortuuid.New(),
+			CreatorID: creatorID,
+			Filename:  attachment.Filename,
+			Type:      attachment.Type,
+			Size:      int64(len(attachment.Blob)),
+			Blob:      attachment.Blob,
+		}
+		if err := SaveResourceBlob(ctx, s.Store, resource); err != nil {
+			return nil, errors.Wrapf(err, "failed to save attachment %s", attachment.Filename)
+		}
+		resources = append(resources, resource)
+	}
+
+	create := &store.Memo{
+		UID:        shortuuid.New(),
+		CreatorID:  creatorID,
+		Content:    memo.Content,
+		Visibility: visibility,
+	}
+	if err := memopayload.RebuildMemoPayload(create); err != nil {
+		return nil, errors.Wrap(err, "failed to rebuild memo payload")
+	}
+	var created *store.Memo
+	if err := s.withTx(ctx, func(tx *APIV1Service) error {
+		var err error
+		created, err = tx.Store.CreateMemo(ctx, create)
+		if err != nil {
+			return errors.Wrap(err, "failed to create memo")
+		}
+		// The store sets the creation time to now, restore the original one.
+		if memo.CreatedTs > 0 {
+			if err := tx.Store.UpdateMemo(ctx, &store.UpdateMemo{
+				ID:        created.ID,
+				CreatedTs: &memo.CreatedTs,
+				UpdatedTs: &memo.CreatedTs,
+			}); err != nil {
+				return errors.Wrap(err, "failed to update memo time")
+			}
+			created.CreatedTs, created.UpdatedTs = memo.CreatedTs, memo.CreatedTs
+		}
+		if err := tx.saveMemoRevision(ctx, nil, created, creatorID); err != nil {
+			return errors.Wrap(err, "failed to save memo revision")
+		}
+		for _, resource := range resources {
+			resource.MemoID = &created.ID
+			if _, err := tx.Store.CreateResource(ctx, resource); err != nil {
+				return errors.Wrapf(err, "failed to create resource %s", resource.Filename)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
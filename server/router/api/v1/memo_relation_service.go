@@ -57,6 +57,9 @@ func (s *APIV1Service) SetMemoRelations(ctx context.Context, request *v1pb.SetMe
 			return nil, status.Errorf(codes.Internal, "failed to upsert memo relation")
 		}
 	}
+	if err := s.touchMemo(ctx, memo.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to touch memo: %v", err)
+	}
 
 	return &emptypb.Empty{}, nil
 }
@@ -109,14 +112,26 @@ func (s *APIV1Service) convertMemoRelationFromStore(ctx context.Context, memoRel
 	if err != nil {
 		return nil, err
 	}
-	memoSnippet, err := getMemoContentSnippet(memo.Content)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get memo content snippet")
-	}
 	relatedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoRelation.RelatedMemoID})
 	if err != nil {
 		return nil, err
 	}
+	if memo == nil || relatedMemo == nil {
+		return nil, errors.New("memo not found")
+	}
+	return convertMemoRelationFromStoreWithMemos(memoRelation, memo, relatedMemo)
+}
+
+// convertMemoRelationFromStoreWithMemos converts the relation between the loaded memos.
+// It returns nil if either memo no longer exists.
+func convertMemoRelationFromStoreWithMemos(memoRelation *store.MemoRelation, memo, relatedMemo *store.Memo) (*v1pb.MemoRelation, error) {
+	if memo == nil || relatedMemo == nil {
+		return nil, nil
+	}
+	memoSnippet, err := getMemoContentSnippet(memo.Content)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get memo content snippet")
+	}
 	relatedMemoSnippet, err := getMemoContentSnippet(relatedMemo.Content)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get related memo content snippet")
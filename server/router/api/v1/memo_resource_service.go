@@ -72,6 +72,9 @@ func (s *APIV1Service) SetMemoResources(ctx context.Context, request *v1pb.SetMe
 			return nil, status.Errorf(codes.Internal, "failed to update resource: %v", err)
 		}
 	}
+	if err := s.touchMemo(ctx, memo.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to touch memo: %v", err)
+	}
 
 	return &emptypb.Empty{}, nil
 }
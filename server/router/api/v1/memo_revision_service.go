@@ -0,0 +1,206 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListMemoRevisions(ctx context.Context, request *v1pb.ListMemoRevisionsRequest) (*v1pb.ListMemoRevisionsResponse, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.getMemoForCreatorAccess(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	memoRevisions, err := s.Store.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo revisions: %v", err)
+	}
+
+	response := &v1pb.ListMemoRevisionsResponse{
+		Revisions: []*v1pb.MemoRevision{},
+	}
+	for index, memoRevision := range memoRevisions {
+		var previous *store.MemoRevision
+		if index+1 < len(memoRevisions) {
+			previous = memoRevisions[index+1]
+		}
+		response.Revisions = append(response.Revisions, convertMemoRevisionFromStore(memo, memoRevision, previous))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetMemoRevision(ctx context.Context, request *v1pb.GetMemoRevisionRequest) (*v1pb.MemoRevision, error) {
+	memoUID, memoRevisionID, err := ExtractMemoRevisionIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo revision name: %v", err)
+	}
+	memo, err := s.getMemoForCreatorAccess(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	memoRevisions, err := s.Store.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo revisions: %v", err)
+	}
+	for index, memoRevision := range memoRevisions {
+		if memoRevision.ID != memoRevisionID {
+			continue
+		}
+		var previous *store.MemoRevision
+		if index+1 < len(memoRevisions) {
+			previous = memoRevisions[index+1]
+		}
+		return convertMemoRevisionFromStore(memo, memoRevision, previous), nil
+	}
+	return nil, status.Errorf(codes.NotFound, "memo revision not found")
+}
+
+func (s *APIV1Service) RestoreMemoRevision(ctx context.Context, request *v1pb.RestoreMemoRevisionRequest) (*v1pb.Memo, error) {
+	memoUID, memoRevisionID, err := ExtractMemoRevisionIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo revision name: %v", err)
+	}
+	memo, err := s.getMemoForCreatorAccess(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+	memoRevision, err := s.Store.GetMemoRevision(ctx, &store.FindMemoRevision{
+		ID:     &memoRevisionID,
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo revision: %v", err)
+	}
+	if memoRevision == nil {
+		return nil, status.Errorf(codes.NotFound, "memo revision not found")
+	}
+
+	// Restoring goes through UpdateMemo, so the restored state is recorded as a new revision.
+	return s.UpdateMemo(ctx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name:       fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+			Content:    memoRevision.Content,
+			Visibility: convertVisibilityFromStore(memoRevision.Visibility),
+			UpdateTime: timestamppb.New(time.Now()),
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content", "visibility", "update_time"}},
+	})
+}
+
+// getMemoForCreatorAccess returns the memo if the current user is its creator or admin.
+// Revisions may contain content from when the memo had a narrower visibility, and share
+// links grant access to private memos, so only the creator or admin can manage them.
+func (s *APIV1Service) getMemoForCreatorAccess(ctx context.Context, memoUID string) (*store.Memo, error) {
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil || (memo.CreatorID != user.ID && !isSuperUser(user)) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return memo, nil
+}
+
+// saveMemoRevision records the current content and visibility of the memo as a new revision.
+// If the memo has no revision yet, the previous state is recorded first so the original text is kept.
+func (s *APIV1Service) saveMemoRevision(ctx context.Context, previous *store.Memo, current *store.Memo, creatorID int32) error {
+	memoRevisions, err := s.Store.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &current.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo revisions")
+	}
+	if len(memoRevisions) == 0 && previous != nil {
+		if _, err := s.Store.CreateMemoRevision(ctx, &store.MemoRevision{
+			MemoID:     previous.ID,
+			CreatorID:  previous.CreatorID,
+			Content:    previous.Content,
+			Visibility: previous.Visibility,
+		}); err != nil {
+			return errors.Wrap(err, "failed to create memo revision")
+		}
+	}
+	if _, err := s.Store.CreateMemoRevision(ctx, &store.MemoRevision{
+		MemoID:     current.ID,
+		CreatorID:  creatorID,
+		Content:    current.Content,
+		Visibility: current.Visibility,
+	}); err != nil {
+		return errors.Wrap(err, "failed to create memo revision")
+	}
+	return s.pruneMemoRevisions(ctx, current.ID)
+}
+
+// pruneMemoRevisions deletes the oldest revisions beyond the workspace revision limit.
+func (s *APIV1Service) pruneMemoRevisions(ctx context.Context, memoID int32) error {
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace memo related setting")
+	}
+	memoRevisions, err := s.Store.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memoID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo revisions")
+	}
+	limit := int(workspaceMemoRelatedSetting.MemoRevisionLimit)
+	if len(memoRevisions) <= limit {
+		return nil
+	}
+	for _, memoRevision := range memoRevisions[limit:] {
+		if err := s.Store.DeleteMemoRevision(ctx, &store.DeleteMemoRevision{ID: &memoRevision.ID}); err != nil {
+			return errors.Wrap(err, "failed to delete memo revision")
+		}
+	}
+	return nil
+}
+
+func convertMemoRevisionFromStore(memo *store.Memo, memoRevision *store.MemoRevision, previous *store.MemoRevision) *v1pb.MemoRevision {
+	name := fmt.Sprintf("%s%s/%s%d", MemoNamePrefix, memo.UID, MemoRevisionNamePrefix, memoRevision.ID)
+	previousName, previousContent := "/dev/null", ""
+	if previous != nil {
+		previousName = fmt.Sprintf("%s%s/%s%d", MemoNamePrefix, memo.UID, MemoRevisionNamePrefix, previous.ID)
+		previousContent = previous.Content
+	}
+	return &v1pb.MemoRevision{
+		Name:       name,
+		Creator:    fmt.Sprintf("%s%d", UserNamePrefix, memoRevision.CreatorID),
+		CreateTime: timestamppb.New(time.Unix(memoRevision.CreatedTs, 0)),
+		Content:    memoRevision.Content,
+		Visibility: convertVisibilityFromStore(memoRevision.Visibility),
+		Diff:       getUnifiedDiff(previousName, previousContent, name, memoRevision.Content),
+	}
+}
+
+func getUnifiedDiff(fromName, fromContent, toName, toContent string) string {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(fromContent),
+		B:        difflib.SplitLines(toContent),
+		FromFile: fromName,
+		ToFile:   toName,
+		Context:  3,
+	})
+	if err != nil {
+		return ""
+	}
+	return diff
+}
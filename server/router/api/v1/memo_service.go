@@ -6,7 +6,9 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"path"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 	"unicode/utf8"
@@ -31,6 +33,36 @@ import (
 )
 
 func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoRequest) (*v1pb.Memo, error) {
+	return runIdempotently(ctx, s, v1pb.MemoService_CreateMemo_FullMethodName, func(record recordIdempotencyKey) (*v1pb.Memo, error) {
+		var memo *store.Memo
+		if err := s.withTx(ctx, func(tx *APIV1Service) error {
+			var err error
+			if memo, err = tx.createMemo(ctx, request); err != nil {
+				return err
+			}
+			return record(tx, fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID))
+		}); err != nil {
+			return nil, err
+		}
+
+		memoMessage, err := s.convertMemoFromStore(ctx, memo)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert memo")
+		}
+		// Try to dispatch webhook when memo is created.
+		if err := s.DispatchMemoCreatedWebhook(ctx, memoMessage); err != nil {
+			slog.Warn("Failed to dispatch memo created webhook", slog.Any("err", err))
+		}
+		s.publishMemoEvent(ctx, v1pb.Event_MEMO_CREATED, memo, memoMessage, nil)
+
+		return memoMessage, nil
+	}, func(name string) (*v1pb.Memo, error) {
+		return s.GetMemo(ctx, &v1pb.GetMemoRequest{Name: name})
+	})
+}
+
+// createMemo creates the memo with its revision, resources and relations.
+func (s *APIV1Service) createMemo(ctx context.Context, request *v1pb.CreateMemoRequest) (*store.Memo, error) {
 	user, err := s.GetCurrentUser(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user")
@@ -67,6 +99,14 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 	if err != nil {
 		return nil, err
 	}
+	if err := s.saveMemoRevision(ctx, nil, memo, user.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save memo revision: %v", err)
+	}
+	if len(request.Memo.Acl) > 0 {
+		if err := s.setMemoACL(ctx, memo, request.Memo.Acl); err != nil {
+			return nil, err
+		}
+	}
 	if len(request.Memo.Resources) > 0 {
 		_, err := s.SetMemoResources(ctx, &v1pb.SetMemoResourcesRequest{
 			Name:      fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
@@ -85,17 +125,7 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 			return nil, errors.Wrap(err, "failed to set memo relations")
 		}
 	}
-
-	memoMessage, err := s.convertMemoFromStore(ctx, memo)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to convert memo")
-	}
-	// Try to dispatch webhook when memo is created.
-	if err := s.DispatchMemoCreatedWebhook(ctx, memoMessage); err != nil {
-		slog.Warn("Failed to dispatch memo created webhook", slog.Any("err", err))
-	}
-
-	return memoMessage, nil
+	return memo, nil
 }
 
 func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosRequest) (*v1pb.ListMemosResponse, error) {
@@ -135,10 +165,27 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user")
 	}
+	if request.Circle != "" {
+		if currentUser == nil {
+			return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+		}
+		// The circle lists the memos of the users followed by the current user with the tag.
+		memoFind.CreatorID = &currentUser.ID
+		memoFind.OrderByPinned = false
+		memoFind.IsFollow = true
+		memoFind.FollowTag = &request.Circle
+	}
 	if currentUser == nil {
 		memoFind.VisibilityList = []store.Visibility{store.Public}
-	} else if memoFind.CreatorID == nil || *memoFind.CreatorID != currentUser.ID {
+	} else if memoFind.IsFollow || memoFind.CreatorID == nil || *memoFind.CreatorID != currentUser.ID {
+		// The memos of the followed users are never the current user's own.
 		memoFind.VisibilityList = []store.Visibility{store.Public, store.Protected}
+		memoFind.ACLPrincipals, err = s.getUserPrincipals(ctx, currentUser)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user principals: %v", err)
+		}
+		memoFind.FollowerID = &currentUser.ID
+		memoFind.MuterID = &currentUser.ID
 	}
 
 	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
@@ -157,6 +204,7 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		}
 		limit = int(pageToken.Limit)
 		offset = int(pageToken.Offset)
+		memoFind.Cursor = convertCursorFromPageToken(&pageToken)
 	} else {
 		limit = int(request.PageSize)
 	}
@@ -171,21 +219,26 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
 	}
 
-	memoMessages := []*v1pb.Memo{}
 	nextPageToken := ""
 	if len(memos) == limitPlusOne {
 		memos = memos[:limit]
-		nextPageToken, err = getPageToken(limit, offset+limit)
+		lastMemo := memos[len(memos)-1]
+		cursor := &store.Cursor{
+			ID:     lastMemo.ID,
+			Ts:     lastMemo.CreatedTs,
+			Pinned: lastMemo.Pinned,
+		}
+		if memoFind.OrderByUpdatedTs {
+			cursor.Ts = lastMemo.UpdatedTs
+		}
+		nextPageToken, err = getCursorPageToken(limit, cursor)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
 		}
 	}
-	for _, memo := range memos {
-		memoMessage, err := s.convertMemoFromStore(ctx, memo)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to convert memo")
-		}
-		memoMessages = append(memoMessages, memoMessage)
+	memoMessages, err := s.convertMemosFromStore(ctx, memos)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memos")
 	}
 
 	response := &v1pb.ListMemosResponse{
@@ -195,6 +248,94 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	return response, nil
 }
 
+func (s *APIV1Service) SearchMemos(ctx context.Context, request *v1pb.SearchMemosRequest) (*v1pb.SearchMemosResponse, error) {
+	if strings.TrimSpace(request.Query) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "query is required")
+	}
+	normalRowStatus := store.Normal
+	memoSearch := &store.SearchMemo{
+		Query:     request.Query,
+		RowStatus: &normalRowStatus,
+		// Exclude comments by default.
+		ExcludeComments: true,
+	}
+	if request.Parent != "" && request.Parent != "users/-" {
+		userID, err := ExtractUserIDFromName(request.Parent)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+		}
+		memoSearch.CreatorID = &userID
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if currentUser == nil {
+		memoSearch.VisibilityList = []store.Visibility{store.Public}
+	} else if memoSearch.CreatorID == nil || *memoSearch.CreatorID != currentUser.ID {
+		// The same memos as ListMemos are searched.
+		memoSearch.VisibilityList = []store.Visibility{store.Public, store.Protected}
+		memoSearch.ACLPrincipals, err = s.getUserPrincipals(ctx, currentUser)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user principals: %v", err)
+		}
+		memoSearch.FollowerID = &currentUser.ID
+		memoSearch.MuterID = &currentUser.ID
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limitPlusOne := limit + 1
+	memoSearch.Limit = &limitPlusOne
+	memoSearch.Offset = &offset
+	searchResults, err := s.Store.SearchMemos(ctx, memoSearch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search memos: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(searchResults) == limitPlusOne {
+		searchResults = searchResults[:limit]
+		nextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+	response := &v1pb.SearchMemosResponse{
+		Results:       []*v1pb.MemoSearchResult{},
+		NextPageToken: nextPageToken,
+	}
+	memos := []*store.Memo{}
+	for _, searchResult := range searchResults {
+		memos = append(memos, searchResult.Memo)
+	}
+	memoMessages, err := s.convertMemosFromStore(ctx, memos)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memos")
+	}
+	for i, searchResult := range searchResults {
+		response.Results = append(response.Results, &v1pb.MemoSearchResult{
+			Memo:    memoMessages[i],
+			Snippet: searchResult.Snippet,
+			Score:   searchResult.Score,
+		})
+	}
+	return response, nil
+}
+
 func (s *APIV1Service) GetMemo(ctx context.Context, request *v1pb.GetMemoRequest) (*v1pb.Memo, error) {
 	memoUID, err := ExtractMemoUIDFromName(request.Name)
 	if err != nil {
@@ -209,16 +350,29 @@ func (s *APIV1Service) GetMemo(ctx context.Context, request *v1pb.GetMemoRequest
 	if memo == nil {
 		return nil, status.Errorf(codes.NotFound, "memo not found")
 	}
+	if memo.RowStatus == store.Trashed {
+		user, err := s.GetCurrentUser(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user")
+		}
+		if !canSeeTrashedMemo(user, memo) {
+			return nil, status.Errorf(codes.NotFound, "memo not found")
+		}
+	}
 	if memo.Visibility != store.Public {
 		user, err := s.GetCurrentUser(ctx)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get user")
 		}
-		if user == nil {
-			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		canRead, err := s.canReadMemo(ctx, user, memo)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check memo access: %v", err)
 		}
-		if memo.Visibility == store.Private && memo.CreatorID != user.ID {
-			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		// Without access of their own, a share link of the memo lets anyone view it.
+		if !canRead {
+			if err := s.viewMemoByShare(ctx, memo); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -250,13 +404,37 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user")
 	}
-	// Only the creator or admin can update the memo.
+	// Only the creator or admin can update the memo, the editors of its ACL can update its content.
 	if memo.CreatorID != user.ID && !isSuperUser(user) {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		role, err := s.getMemoACLRole(ctx, user, memo.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo acl role: %v", err)
+		}
+		if role != store.MemoACLRoleEditor {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+		for _, path := range request.UpdateMask.Paths {
+			if !memoEditorUpdatePaths[path] {
+				return nil, status.Errorf(codes.PermissionDenied, "editors cannot update %s", path)
+			}
+		}
+	}
+	if memo.RowStatus == store.Trashed {
+		return nil, status.Errorf(codes.FailedPrecondition, "memo is in the trash")
+	}
+	if request.Memo.Etag != "" && request.Memo.Etag != getMemoEtag(memo) {
+		memoMessage, err := s.convertMemoFromStore(ctx, memo)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert memo")
+		}
+		return nil, newEtagMismatchError(memoMessage)
 	}
 
+	previous := *memo
+	// The update is based on the version read, it fails if the memo is updated concurrently.
 	update := &store.UpdateMemo{
-		ID: memo.ID,
+		ID:      memo.ID,
+		Version: &memo.Version,
 	}
 	for _, path := range request.UpdateMask.Paths {
 		if path == "content" {
@@ -287,6 +465,9 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 			update.Pinned = &request.Memo.Pinned
 		} else if path == "state" {
 			rowStatus := convertStateToStore(request.Memo.State)
+			if rowStatus == store.Trashed {
+				return nil, status.Errorf(codes.InvalidArgument, "use DeleteMemo to move the memo to the trash")
+			}
 			update.RowStatus = &rowStatus
 		} else if path == "create_time" {
 			createdTs := request.Memo.CreateTime.AsTime().Unix()
@@ -312,27 +493,73 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 			payload := memo.Payload
 			payload.Location = convertLocationToStore(request.Memo.Location)
 			update.Payload = payload
-		} else if path == "resources" {
-			_, err := s.SetMemoResources(ctx, &v1pb.SetMemoResourcesRequest{
-				Name:      request.Memo.Name,
-				Resources: request.Memo.Resources,
-			})
-			if err != nil {
-				return nil, errors.Wrap(err, "failed to set memo resources")
-			}
-		} else if path == "relations" {
-			_, err := s.SetMemoRelations(ctx, &v1pb.SetMemoRelationsRequest{
-				Name:      request.Memo.Name,
-				Relations: request.Memo.Relations,
-			})
-			if err != nil {
-				return nil, errors.Wrap(err, "failed to set memo relations")
-			}
 		}
 	}
 
-	if err = s.Store.UpdateMemo(ctx, update); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update memo")
+	// The update time is the time of the change, unless it is set by the request.
+	if update.UpdatedTs == nil {
+		updatedTs := time.Now().Unix()
+		update.UpdatedTs = &updatedTs
+	}
+	// The memo is updated before its resources, relations and ACL, which touch the memo and bump its version.
+	if err := s.withTx(ctx, func(tx *APIV1Service) error {
+		if err := tx.Store.UpdateMemo(ctx, update); err != nil {
+			if errors.Is(err, store.ErrMemoVersionMismatch) {
+				return err
+			}
+			return status.Errorf(codes.Internal, "failed to update memo")
+		}
+		for _, path := range request.UpdateMask.Paths {
+			if path == "resources" {
+				if _, err := tx.SetMemoResources(ctx, &v1pb.SetMemoResourcesRequest{
+					Name:      request.Memo.Name,
+					Resources: request.Memo.Resources,
+				}); err != nil {
+					return errors.Wrap(err, "failed to set memo resources")
+				}
+			} else if path == "relations" {
+				if _, err := tx.SetMemoRelations(ctx, &v1pb.SetMemoRelationsRequest{
+					Name:      request.Memo.Name,
+					Relations: request.Memo.Relations,
+				}); err != nil {
+					return errors.Wrap(err, "failed to set memo relations")
+				}
+			} else if path == "acl" {
+				if err := tx.setMemoACL(ctx, memo, request.Memo.Acl); err != nil {
+					return err
+				}
+			}
+		}
+		if update.RowStatus != nil && *update.RowStatus == store.Archived && previous.RowStatus != store.Archived {
+			if err := tx.Store.CreateMemoTombstone(ctx, memo, store.TombstoneReasonArchived); err != nil {
+				return status.Errorf(codes.Internal, "failed to create memo tombstone: %v", err)
+			}
+		}
+		// The revision is saved with the update, so the previous content is never lost.
+		current, err := tx.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		if current.Content != previous.Content || current.Visibility != previous.Visibility {
+			if err := tx.saveMemoRevision(ctx, &previous, current, user.ID); err != nil {
+				return status.Errorf(codes.Internal, "failed to save memo revision: %v", err)
+			}
+		}
+		return nil
+	}); err != nil {
+		if !errors.Is(err, store.ErrMemoVersionMismatch) {
+			return nil, err
+		}
+		// The memo has been updated since it was read, the current version is returned to the client.
+		current, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		memoMessage, err := s.convertMemoFromStore(ctx, current)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert memo")
+		}
+		return nil, newEtagMismatchError(memoMessage)
 	}
 
 	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{
@@ -349,6 +576,7 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	if err := s.DispatchMemoUpdatedWebhook(ctx, memoMessage); err != nil {
 		slog.Warn("Failed to dispatch memo updated webhook", slog.Any("err", err))
 	}
+	s.publishMemoEvent(ctx, v1pb.Event_MEMO_UPDATED, memo, memoMessage, nil)
 
 	return memoMessage, nil
 }
@@ -376,55 +604,35 @@ func (s *APIV1Service) DeleteMemo(ctx context.Context, request *v1pb.DeleteMemoR
 	if memo.CreatorID != user.ID && !isSuperUser(user) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
+	if memo.RowStatus == store.Trashed {
+		return nil, status.Errorf(codes.FailedPrecondition, "memo is already in the trash")
+	}
 
-	if memoMessage, err := s.convertMemoFromStore(ctx, memo); err == nil {
+	memoMessage, err := s.convertMemoFromStore(ctx, memo)
+	if err == nil {
 		// Try to dispatch webhook when memo is deleted.
 		if err := s.DispatchMemoDeletedWebhook(ctx, memoMessage); err != nil {
 			slog.Warn("Failed to dispatch memo deleted webhook", slog.Any("err", err))
 		}
 	}
 
-	if err = s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo")
-	}
-
-	// Delete memo relation
-	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{MemoID: &memo.ID}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo relations")
-	}
-
-	// Delete related resources.
-	resources, err := s.Store.ListResources(ctx, &store.FindResource{MemoID: &memo.ID})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list resources")
-	}
-	for _, resource := range resources {
-		if err := s.Store.DeleteResource(ctx, &store.DeleteResource{ID: resource.ID}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to delete resource")
-		}
-	}
-
-	// Delete memo comments
-	commentType := store.MemoRelationComment
-	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoID: &memo.ID, Type: &commentType})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list memo comments")
+	// Move the memo to the trash, it is purged after the retention period.
+	if err := s.Store.TrashMemo(ctx, memo); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to trash memo: %v", err)
 	}
-	for _, relation := range relations {
-		if err := s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: relation.MemoID}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to delete memo comment")
-		}
-	}
-
-	// Delete memo references
-	referenceType := store.MemoRelationReference
-	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{RelatedMemoID: &memo.ID, Type: &referenceType}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo references")
+	if memoMessage != nil {
+		s.publishMemoEvent(ctx, v1pb.Event_MEMO_DELETED, memo, memoMessage, nil)
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
+// exportConvertBatchSize is the number of memos converted at once when exporting.
+const exportConvertBatchSize = 100
+
+// memoExportTitleRegexp matches the special characters not allowed in the exported file names.
+var memoExportTitleRegexp = regexp.MustCompile(`[<>"\\/:|?*]`)
+
 func (s *APIV1Service) ExportMemos(ctx context.Context, request *v1pb.ExportMemosRequest) (*v1pb.ExportMemosResponse, error) {
 	normalRowStatus := store.Normal
 	memoFind := &store.FindMemo{
@@ -444,49 +652,35 @@ func (s *APIV1Service) ExportMemos(ctx context.Context, request *v1pb.ExportMemo
 		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
 	}
 
-	buf := new(bytes.Buffer)
-	writer := zip.NewWriter(buf)
-	for _, memo := range memos {
-		memoMessage, err := s.convertMemoFromStore(ctx, memo)
+	// Convert the memos in chunks to bound the size of the batch queries.
+	memoMessages := []*v1pb.Memo{}
+	for chunk := range slices.Chunk(memos, exportConvertBatchSize) {
+		chunkMessages, err := s.convertMemosFromStore(ctx, chunk)
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to convert memo")
+			return nil, errors.Wrap(err, "failed to convert memos")
 		}
+		memoMessages = append(memoMessages, chunkMessages...)
+	}
+
+	buf := new(bytes.Buffer)
+	writer := zip.NewWriter(buf)
+	for i, memo := range memos {
+		memoMessage := memoMessages[i]
 		// file, err := writer.Create(time.Unix(memo.CreatedTs, 0).Format(time.RFC3339) + "-" + memo.UID + "-" + string(memo.Visibility) + ".md")
 		// if err != nil {
 		// 	return nil, status.Errorf(codes.Internal, "Failed to create memo file")
 		// }
 
-		// 获取创建时间
-		createTime := time.Unix(memo.CreatedTs, 0)
-		year := createTime.Format("2006")
-		month := createTime.Format("01")
-		day := createTime.Format("02")
-		hour := createTime.Format("15")
-		minute := createTime.Format("04")
-		second := createTime.Format("05")
-
-		// Get the first 200 characters or less of the content before the first newline
-		contentPrefix := getMemoContentPrefix(memoMessage.Content)
-		// 定义正则表达式，匹配所有特殊字符
-		re := regexp.MustCompile(`[<>"\\/:|?*]`)
-		// 替换特殊字符
-		santizeTitle := re.ReplaceAllString(contentPrefix, "-")
-		// log.Printf("santizeTitle: %v", santizeTitle)
-
-		// Construct the file name
-		fileName := fmt.Sprintf("%s%s%s_%s%s%s_%s_%s.md", year, month, day, hour, minute, second, santizeTitle, memo.Visibility)
-
-		// 创建文件夹路径：年/月
-		folderPath := fmt.Sprintf("%s/%s/", year, month)
+		filePath := getMemoExportPath(memo)
 
 		// 创建文件夹（如果不存在）
-		_, err = writer.Create(folderPath)
+		_, err = writer.Create(path.Dir(filePath) + "/")
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Failed to create folder: %v", err)
 		}
 
 		// 创建文件
-		file, err := writer.Create(folderPath + fileName)
+		file, err := writer.Create(filePath + ".md")
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Failed to create memo file")
 		}
@@ -504,6 +698,17 @@ func (s *APIV1Service) ExportMemos(ctx context.Context, request *v1pb.ExportMemo
 	}, nil
 }
 
+// getMemoExportPath returns the path of the memo in the export without the extension,
+// e.g. "2024/01/20240102_150405_title_PRIVATE".
+func getMemoExportPath(memo *store.Memo) string {
+	createTime := time.Unix(memo.CreatedTs, 0)
+	// Get the first 200 characters or less of the content before the first newline
+	contentPrefix := getMemoContentPrefix(memo.Content)
+	// 替换所有特殊字符
+	santizeTitle := memoExportTitleRegexp.ReplaceAllString(contentPrefix, "-")
+	return fmt.Sprintf("%s/%s_%s_%s", createTime.Format("2006/01"), createTime.Format("20060102_150405"), santizeTitle, memo.Visibility)
+}
+
 // Helper function to get the first 200 characters or less of the content before the first newline
 func getMemoContentPrefix(content string) string {
 	const prefixLimit = 200
@@ -539,60 +744,93 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get memo")
 	}
-
-	// Create the memo comment first.
-	memoComment, err := s.CreateMemo(ctx, &v1pb.CreateMemoRequest{Memo: request.Comment})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create memo")
+	if relatedMemo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
 	}
-	memoUID, err = ExtractMemoUIDFromName(memoComment.Name)
+	user, err := s.GetCurrentUser(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
 	}
-	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	canRead, err := s.canReadMemo(ctx, user, relatedMemo)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get memo")
+		return nil, status.Errorf(codes.Internal, "failed to check memo access: %v", err)
 	}
-
-	// Build the relation between the comment memo and the original memo.
-	_, err = s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
-		MemoID:        memo.ID,
-		RelatedMemoID: relatedMemo.ID,
-		Type:          store.MemoRelationComment,
-	})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create memo relation")
+	if !canRead {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
-	creatorID, err := ExtractUserIDFromName(memoComment.Creator)
+	blocked, err := s.isBlockedByUser(ctx, relatedMemo.CreatorID, user.ID)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid memo creator")
+		return nil, status.Errorf(codes.Internal, "failed to check user block: %v", err)
 	}
-	if memoComment.Visibility != v1pb.Visibility_PRIVATE && creatorID != relatedMemo.CreatorID {
-		activity, err := s.Store.CreateActivity(ctx, &store.Activity{
-			CreatorID: creatorID,
-			Type:      store.ActivityTypeMemoComment,
-			Level:     store.ActivityLevelInfo,
-			Payload: &storepb.ActivityPayload{
-				MemoComment: &storepb.ActivityMemoCommentPayload{
-					MemoId:        memo.ID,
-					RelatedMemoId: relatedMemo.ID,
-				},
-			},
+	if blocked {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	var memo *store.Memo
+	var inbox *store.Inbox
+	if err := s.withTx(ctx, func(tx *APIV1Service) error {
+		// Create the memo comment first.
+		memo, err = tx.createMemo(ctx, &v1pb.CreateMemoRequest{Memo: request.Comment})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to create memo")
+		}
+
+		// Build the relation between the comment memo and the original memo.
+		_, err = tx.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
+			MemoID:        memo.ID,
+			RelatedMemoID: relatedMemo.ID,
+			Type:          store.MemoRelationComment,
 		})
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to create activity")
-		}
-		if _, err := s.Store.CreateInbox(ctx, &store.Inbox{
-			SenderID:   creatorID,
-			ReceiverID: relatedMemo.CreatorID,
-			Status:     store.UNREAD,
-			Message: &storepb.InboxMessage{
-				Type:       storepb.InboxMessage_MEMO_COMMENT,
-				ActivityId: &activity.ID,
-			},
-		}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to create inbox")
+			return status.Errorf(codes.Internal, "failed to create memo relation")
+		}
+		if memo.Visibility != store.Private && memo.CreatorID != relatedMemo.CreatorID {
+			activity, err := tx.Store.CreateActivity(ctx, &store.Activity{
+				CreatorID: memo.CreatorID,
+				Type:      store.ActivityTypeMemoComment,
+				Level:     store.ActivityLevelInfo,
+				Payload: &storepb.ActivityPayload{
+					MemoComment: &storepb.ActivityMemoCommentPayload{
+						MemoId:        memo.ID,
+						RelatedMemoId: relatedMemo.ID,
+					},
+				},
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to create activity")
+			}
+			inbox, err = tx.Store.CreateInbox(ctx, &store.Inbox{
+				SenderID:   memo.CreatorID,
+				ReceiverID: relatedMemo.CreatorID,
+				Status:     store.UNREAD,
+				Message: &storepb.InboxMessage{
+					Type:       storepb.InboxMessage_MEMO_COMMENT,
+					ActivityId: &activity.ID,
+				},
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to create inbox")
+			}
 		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	memoComment, err := s.convertMemoFromStore(ctx, memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memo")
+	}
+	// Try to dispatch webhook when memo is created.
+	if err := s.DispatchMemoCreatedWebhook(ctx, memoComment); err != nil {
+		slog.Warn("Failed to dispatch memo created webhook", slog.Any("err", err))
+	}
+	s.publishMemoEvent(ctx, v1pb.Event_MEMO_COMMENT_CREATED, memo, memoComment, relatedMemo)
+	if inbox != nil {
+		s.publishInboxEvent(v1pb.Event_INBOX_CREATED, inbox)
 	}
 
 	return memoComment, nil
@@ -616,22 +854,31 @@ func (s *APIV1Service) ListMemoComments(ctx context.Context, request *v1pb.ListM
 		return nil, status.Errorf(codes.Internal, "failed to list memo relations")
 	}
 
-	var memos []*v1pb.Memo
+	commentIDs := []int32{}
 	for _, memoRelation := range memoRelations {
-		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
-			ID: &memoRelation.MemoID,
-		})
+		commentIDs = append(commentIDs, memoRelation.MemoID)
+	}
+	comments := []*store.Memo{}
+	if len(commentIDs) > 0 {
+		commentList, err := s.Store.ListMemos(ctx, &store.FindMemo{IDList: commentIDs})
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get memo")
+			return nil, status.Errorf(codes.Internal, "failed to list memos")
 		}
-		if memo != nil {
-			memoMessage, err := s.convertMemoFromStore(ctx, memo)
-			if err != nil {
-				return nil, errors.Wrap(err, "failed to convert memo")
+		commentMap := map[int32]*store.Memo{}
+		for _, comment := range commentList {
+			commentMap[comment.ID] = comment
+		}
+		// Keep the comments in the order of their relations.
+		for _, commentID := range commentIDs {
+			if comment, ok := commentMap[commentID]; ok && comment.RowStatus != store.Trashed {
+				comments = append(comments, comment)
 			}
-			memos = append(memos, memoMessage)
 		}
 	}
+	memos, err := s.convertMemosFromStore(ctx, comments)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memos")
+	}
 
 	response := &v1pb.ListMemoCommentsResponse{
 		Memos: memos,
@@ -662,31 +909,50 @@ func (s *APIV1Service) RenameMemoTag(ctx context.Context, request *v1pb.RenameMe
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list memos")
 	}
+	memos = excludeTrashedMemos(memos)
 
+	if err := s.withTx(ctx, func(tx *APIV1Service) error {
+		return tx.renameTagInMemos(ctx, memos, request.OldTag, request.NewTag, user.ID)
+	}); err != nil {
+		return nil, err
+	}
+	s.publishMemosEvent(ctx, v1pb.Event_MEMO_UPDATED, memos)
+
+	return &emptypb.Empty{}, nil
+}
+
+// renameTagInMemos replaces the oldTag with the newTag in the content of the given memos,
+// and saves a revision of every updated memo.
+func (s *APIV1Service) renameTagInMemos(ctx context.Context, memos []*store.Memo, oldTag, newTag string, userID int32) error {
 	for _, memo := range memos {
+		previous := *memo
 		nodes, err := parser.Parse(tokenizer.Tokenize(memo.Content))
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to parse memo: %v", err)
+			return status.Errorf(codes.Internal, "failed to parse memo: %v", err)
 		}
 		memopayload.TraverseASTNodes(nodes, func(node ast.Node) {
-			if tag, ok := node.(*ast.Tag); ok && tag.Content == request.OldTag {
-				tag.Content = request.NewTag
+			if tag, ok := node.(*ast.Tag); ok && tag.Content == oldTag {
+				tag.Content = newTag
 			}
 		})
 		memo.Content = restore.Restore(nodes)
 		if err := memopayload.RebuildMemoPayload(memo); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
+			return status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
 		}
+		updatedTs := time.Now().Unix()
 		if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
-			ID:      memo.ID,
-			Content: &memo.Content,
-			Payload: memo.Payload,
+			ID:        memo.ID,
+			UpdatedTs: &updatedTs,
+			Content:   &memo.Content,
+			Payload:   memo.Payload,
 		}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to update memo: %v", err)
+			return status.Errorf(codes.Internal, "failed to update memo: %v", err)
+		}
+		if err := s.saveMemoRevision(ctx, &previous, memo, userID); err != nil {
+			return status.Errorf(codes.Internal, "failed to save memo revision: %v", err)
 		}
 	}
-
-	return &emptypb.Empty{}, nil
+	return nil
 }
 
 func (s *APIV1Service) DeleteMemoTag(ctx context.Context, request *v1pb.DeleteMemoTagRequest) (*emptypb.Empty, error) {
@@ -713,28 +979,51 @@ func (s *APIV1Service) DeleteMemoTag(ctx context.Context, request *v1pb.DeleteMe
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list memos")
 	}
+	memos = excludeTrashedMemos(memos)
 
-	for _, memo := range memos {
-		if request.DeleteRelatedMemos {
-			err := s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID})
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to delete memo")
-			}
-		} else {
-			archived := store.Archived
-			err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
-				ID:        memo.ID,
-				RowStatus: &archived,
-			})
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to update memo")
+	if err := s.Store.WithTx(ctx, func(tx *store.Store) error {
+		for _, memo := range memos {
+			if request.DeleteRelatedMemos {
+				if err := tx.TrashMemo(ctx, memo); err != nil {
+					return status.Errorf(codes.Internal, "failed to trash memo")
+				}
+			} else {
+				archived := store.Archived
+				err := tx.UpdateMemo(ctx, &store.UpdateMemo{
+					ID:        memo.ID,
+					RowStatus: &archived,
+				})
+				if err != nil {
+					return status.Errorf(codes.Internal, "failed to update memo")
+				}
+				if memo.RowStatus == store.Archived {
+					continue
+				}
+				if err := tx.CreateMemoTombstone(ctx, memo, store.TombstoneReasonArchived); err != nil {
+					return status.Errorf(codes.Internal, "failed to create memo tombstone")
+				}
 			}
 		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if request.DeleteRelatedMemos {
+		s.publishMemosEvent(ctx, v1pb.Event_MEMO_DELETED, memos)
+	} else {
+		s.publishMemosEvent(ctx, v1pb.Event_MEMO_UPDATED, memos)
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
+// excludeTrashedMemos leaves the memos in the trash out, they are only changed by restoring or purging them.
+func excludeTrashedMemos(memos []*store.Memo) []*store.Memo {
+	return slices.DeleteFunc(memos, func(memo *store.Memo) bool {
+		return memo.RowStatus == store.Trashed
+	})
+}
+
 func (s *APIV1Service) getContentLengthLimit(ctx context.Context) (int, error) {
 	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
 	if err != nil {
@@ -3,6 +3,8 @@ package v1
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
@@ -17,75 +19,179 @@ import (
 )
 
 func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Memo) (*v1pb.Memo, error) {
-	displayTs := memo.CreatedTs
+	memoMessages, err := s.convertMemosFromStore(ctx, []*store.Memo{memo})
+	if err != nil {
+		return nil, err
+	}
+	return memoMessages[0], nil
+}
+
+// convertMemosFromStore converts a page of memos, loading their parents, relations, resources,
+// reactions and ACLs in a constant number of queries regardless of the page size.
+func (s *APIV1Service) convertMemosFromStore(ctx context.Context, memos []*store.Memo) ([]*v1pb.Memo, error) {
+	memoMessages := []*v1pb.Memo{}
+	if len(memos) == 0 {
+		return memoMessages, nil
+	}
 	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get workspace memo related setting")
 	}
-	if workspaceMemoRelatedSetting.DisplayWithUpdateTime {
-		displayTs = memo.UpdatedTs
-	}
-
-	name := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
-	memoMessage := &v1pb.Memo{
-		Name:        name,
-		State:       convertStateFromStore(memo.RowStatus),
-		Creator:     fmt.Sprintf("%s%d", UserNamePrefix, memo.CreatorID),
-		CreateTime:  timestamppb.New(time.Unix(memo.CreatedTs, 0)),
-		UpdateTime:  timestamppb.New(time.Unix(memo.UpdatedTs, 0)),
-		DisplayTime: timestamppb.New(time.Unix(displayTs, 0)),
-		Content:     memo.Content,
-		Visibility:  convertVisibilityFromStore(memo.Visibility),
-		Pinned:      memo.Pinned,
-	}
-	if memo.Payload != nil {
-		memoMessage.Tags = memo.Payload.Tags
-		memoMessage.Property = convertMemoPropertyFromStore(memo.Payload.Property)
-		memoMessage.Location = convertLocationFromStore(memo.Payload.Location)
-	}
-	if memo.ParentID != nil {
-		parent, err := s.Store.GetMemo(ctx, &store.FindMemo{
-			ID:             memo.ParentID,
-			ExcludeContent: true,
-		})
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to get parent memo")
-		}
-		parentName := fmt.Sprintf("%s%s", MemoNamePrefix, parent.UID)
-		memoMessage.Parent = &parentName
+
+	memoIDs := []int32{}
+	memoNames := []string{}
+	memoMap := map[int32]*store.Memo{}
+	for _, memo := range memos {
+		memoIDs = append(memoIDs, memo.ID)
+		memoNames = append(memoNames, fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID))
+		memoMap[memo.ID] = memo
 	}
 
-	listMemoRelationsResponse, err := s.ListMemoRelations(ctx, &v1pb.ListMemoRelationsRequest{Name: name})
+	memoRelations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{MemoIDList: memoIDs})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to list memo relations")
 	}
-	memoMessage.Relations = listMemoRelationsResponse.Relations
-
-	listMemoResourcesResponse, err := s.ListMemoResources(ctx, &v1pb.ListMemoResourcesRequest{Name: name})
+	relatedMemoRelations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoIDList: memoIDs})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to list memo resources")
+		return nil, errors.Wrap(err, "failed to list related memo relations")
+	}
+	// Load the parents and the other side of the relations that are not in the page.
+	missingMemoIDs := []int32{}
+	addMissingMemoID := func(id int32) {
+		if _, ok := memoMap[id]; !ok && !slices.Contains(missingMemoIDs, id) {
+			missingMemoIDs = append(missingMemoIDs, id)
+		}
+	}
+	for _, memo := range memos {
+		if memo.ParentID != nil {
+			addMissingMemoID(*memo.ParentID)
+		}
+	}
+	for _, memoRelation := range append(slices.Clone(memoRelations), relatedMemoRelations...) {
+		addMissingMemoID(memoRelation.MemoID)
+		addMissingMemoID(memoRelation.RelatedMemoID)
+	}
+	if len(missingMemoIDs) > 0 {
+		missingMemos, err := s.Store.ListMemos(ctx, &store.FindMemo{IDList: missingMemoIDs})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list related memos")
+		}
+		for _, memo := range missingMemos {
+			memoMap[memo.ID] = memo
+		}
+	}
+
+	relationsMap := map[int32][]*v1pb.MemoRelation{}
+	for _, memoRelation := range memoRelations {
+		relation, err := convertMemoRelationFromStoreWithMemos(memoRelation, memoMap[memoRelation.MemoID], memoMap[memoRelation.RelatedMemoID])
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert memo relation")
+		}
+		if relation != nil {
+			relationsMap[memoRelation.MemoID] = append(relationsMap[memoRelation.MemoID], relation)
+		}
+	}
+	for _, memoRelation := range relatedMemoRelations {
+		relation, err := convertMemoRelationFromStoreWithMemos(memoRelation, memoMap[memoRelation.MemoID], memoMap[memoRelation.RelatedMemoID])
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert memo relation")
+		}
+		if relation != nil {
+			relationsMap[memoRelation.RelatedMemoID] = append(relationsMap[memoRelation.RelatedMemoID], relation)
+		}
 	}
-	memoMessage.Resources = listMemoResourcesResponse.Resources
 
-	listMemoReactionsResponse, err := s.ListMemoReactions(ctx, &v1pb.ListMemoReactionsRequest{Name: name})
+	resources, err := s.Store.ListResources(ctx, &store.FindResource{MemoIDList: memoIDs})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to list memo reactions")
+		return nil, errors.Wrap(err, "failed to list memo resources")
+	}
+	resourcesMap := map[int32][]*v1pb.Resource{}
+	for _, resource := range resources {
+		resourcesMap[*resource.MemoID] = append(resourcesMap[*resource.MemoID], convertResourceFromStoreWithMemo(resource, memoMap[*resource.MemoID]))
 	}
-	memoMessage.Reactions = listMemoReactionsResponse.Reactions
 
-	nodes, err := parser.Parse(tokenizer.Tokenize(memo.Content))
+	memoACLs, err := s.Store.ListMemoACLs(ctx, &store.FindMemoACL{MemoIDList: memoIDs})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to parse content")
+		return nil, errors.Wrap(err, "failed to list memo acl")
+	}
+	aclMap := map[int32][]*v1pb.MemoACL{}
+	for _, memoACL := range memoACLs {
+		aclMap[memoACL.MemoID] = append(aclMap[memoACL.MemoID], convertMemoACLFromStore(memoACL))
 	}
-	memoMessage.Nodes = convertFromASTNodes(nodes)
 
-	snippet, err := getMemoContentSnippet(memo.Content)
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentIDList: memoNames})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get memo content snippet")
+		return nil, errors.Wrap(err, "failed to list memo reactions")
+	}
+	reactionsMap := map[string][]*v1pb.Reaction{}
+	for _, reaction := range reactions {
+		reactionsMap[reaction.ContentID] = append(reactionsMap[reaction.ContentID], &v1pb.Reaction{
+			Id:           reaction.ID,
+			Creator:      fmt.Sprintf("%s%d", UserNamePrefix, reaction.CreatorID),
+			ContentId:    reaction.ContentID,
+			ReactionType: reaction.ReactionType,
+		})
 	}
-	memoMessage.Snippet = snippet
 
-	return memoMessage, nil
+	for i, memo := range memos {
+		displayTs := memo.CreatedTs
+		if workspaceMemoRelatedSetting.DisplayWithUpdateTime {
+			displayTs = memo.UpdatedTs
+		}
+
+		name := memoNames[i]
+		memoMessage := &v1pb.Memo{
+			Name:        name,
+			State:       convertStateFromStore(memo.RowStatus),
+			Creator:     fmt.Sprintf("%s%d", UserNamePrefix, memo.CreatorID),
+			CreateTime:  timestamppb.New(time.Unix(memo.CreatedTs, 0)),
+			UpdateTime:  timestamppb.New(time.Unix(memo.UpdatedTs, 0)),
+			DisplayTime: timestamppb.New(time.Unix(displayTs, 0)),
+			Content:     memo.Content,
+			Visibility:  convertVisibilityFromStore(memo.Visibility),
+			Pinned:      memo.Pinned,
+			Relations:   []*v1pb.MemoRelation{},
+			Resources:   []*v1pb.Resource{},
+			Reactions:   []*v1pb.Reaction{},
+			Acl:         []*v1pb.MemoACL{},
+			Etag:        getMemoEtag(memo),
+		}
+		if memo.TrashedTs > 0 {
+			memoMessage.TrashTime = timestamppb.New(time.Unix(memo.TrashedTs, 0))
+		}
+		if memo.Payload != nil {
+			memoMessage.Tags = memo.Payload.Tags
+			memoMessage.Property = convertMemoPropertyFromStore(memo.Payload.Property)
+			memoMessage.Location = convertLocationFromStore(memo.Payload.Location)
+		}
+		if memo.ParentID != nil {
+			parent, ok := memoMap[*memo.ParentID]
+			if !ok {
+				return nil, errors.Errorf("parent memo %d not found", *memo.ParentID)
+			}
+			parentName := fmt.Sprintf("%s%s", MemoNamePrefix, parent.UID)
+			memoMessage.Parent = &parentName
+		}
+		memoMessage.Relations = append(memoMessage.Relations, relationsMap[memo.ID]...)
+		memoMessage.Resources = append(memoMessage.Resources, resourcesMap[memo.ID]...)
+		memoMessage.Reactions = append(memoMessage.Reactions, reactionsMap[name]...)
+		memoMessage.Acl = append(memoMessage.Acl, aclMap[memo.ID]...)
+
+		nodes, err := parser.Parse(tokenizer.Tokenize(memo.Content))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse content")
+		}
+		memoMessage.Nodes = convertFromASTNodes(nodes)
+
+		snippet, err := getMemoContentSnippet(memo.Content)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get memo content snippet")
+		}
+		memoMessage.Snippet = snippet
+
+		memoMessages = append(memoMessages, memoMessage)
+	}
+	return memoMessages, nil
 }
 
 func convertMemoPropertyFromStore(property *storepb.MemoPayload_Property) *v1pb.MemoProperty {
@@ -128,6 +234,8 @@ func convertVisibilityFromStore(visibility store.Visibility) v1pb.Visibility {
 		return v1pb.Visibility_PRIVATE
 	case store.Protected:
 		return v1pb.Visibility_PROTECTED
+	case store.Followers:
+		return v1pb.Visibility_FOLLOWERS
 	case store.Public:
 		return v1pb.Visibility_PUBLIC
 	default:
@@ -141,9 +249,17 @@ func convertVisibilityToStore(visibility v1pb.Visibility) store.Visibility {
 		return store.Private
 	case v1pb.Visibility_PROTECTED:
 		return store.Protected
+	case v1pb.Visibility_FOLLOWERS:
+		return store.Followers
 	case v1pb.Visibility_PUBLIC:
 		return store.Public
 	default:
 		return store.Private
 	}
 }
+
+// getMemoEtag returns the etag of the memo version. The version is bumped by every update of the memo,
+// including its resources and relations, so two versions never share an etag.
+func getMemoEtag(memo *store.Memo) string {
+	return getEtag(strconv.FormatInt(int64(memo.ID), 10), strconv.FormatInt(int64(memo.Version), 10))
+}
@@ -0,0 +1,131 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoTagSkipsTrashedMemos(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "test", store.RoleHost)
+	userCtx := withTestingUser(ctx, user)
+
+	trashedMemo, err := s.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "trashed #tag", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+	memo, err := s.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "normal #tag", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+	_, err = s.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: trashedMemo.Name})
+	require.NoError(t, err)
+	trashedUID, err := ExtractMemoUIDFromName(trashedMemo.Name)
+	require.NoError(t, err)
+	trashed, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &trashedUID})
+	require.NoError(t, err)
+
+	_, err = s.RenameMemoTag(userCtx, &v1pb.RenameMemoTagRequest{Parent: "memos/-", OldTag: "tag", NewTag: "renamed"})
+	require.NoError(t, err)
+	_, err = s.MergeTags(userCtx, &v1pb.MergeTagsRequest{Parent: fmt.Sprintf("%s%d", UserNamePrefix, user.ID), SourceTags: []string{"tag"}, TargetTag: "merged"})
+	require.NoError(t, err)
+	_, err = s.DeleteMemoTag(userCtx, &v1pb.DeleteMemoTagRequest{Parent: "memos/-", Tag: "renamed"})
+	require.NoError(t, err)
+
+	// The memo in the trash keeps its content, status and trash time.
+	memoAfter, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &trashedUID})
+	require.NoError(t, err)
+	require.Equal(t, store.Trashed, memoAfter.RowStatus)
+	require.Equal(t, trashed.TrashedTs, memoAfter.TrashedTs)
+	require.Equal(t, "trashed #tag", memoAfter.Content)
+	memoUID, err := ExtractMemoUIDFromName(memo.Name)
+	require.NoError(t, err)
+	memoAfter, err = s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	require.NoError(t, err)
+	require.Equal(t, store.Archived, memoAfter.RowStatus)
+	require.Equal(t, "normal #renamed", memoAfter.Content)
+
+	// Only the normal memo is archived, the memo in the trash has its tombstone from the deletion only.
+	tombstones, err := s.Store.ListTombstones(ctx, &store.FindTombstone{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(tombstones))
+}
+
+func TestSearchMemosVisibility(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	creator := createTestingUser(ctx, t, s, "creator", store.RoleUser)
+	mutedUser := createTestingUser(ctx, t, s, "muted", store.RoleUser)
+	creatorCtx := withTestingUser(ctx, creator)
+	for _, memo := range []*v1pb.Memo{
+		{Content: "alpha shared", Visibility: v1pb.Visibility_PRIVATE, Acl: []*v1pb.MemoACL{{Principal: getUserPrincipal(user), Role: v1pb.MemoACL_READER}}},
+		{Content: "alpha followers", Visibility: v1pb.Visibility_FOLLOWERS},
+		{Content: "alpha private", Visibility: v1pb.Visibility_PRIVATE},
+	} {
+		_, err := s.CreateMemo(creatorCtx, &v1pb.CreateMemoRequest{Memo: memo})
+		require.NoError(t, err)
+	}
+	_, err := s.CreateMemo(withTestingUser(ctx, mutedUser), &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "alpha muted", Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+	require.NoError(t, s.Store.FollowUser(ctx, &store.UserFollowing{UserID: user.ID, FollowingUserID: creator.ID}))
+	_, err = s.Store.UpsertUserBlock(ctx, &store.UserBlock{UserID: user.ID, BlockedUserID: mutedUser.ID, Type: store.UserBlockTypeMute})
+	require.NoError(t, err)
+
+	// The search finds the same memos as ListMemos.
+	response, err := s.SearchMemos(withTestingUser(ctx, user), &v1pb.SearchMemosRequest{Query: "alpha"})
+	require.NoError(t, err)
+	contents := []string{}
+	for _, result := range response.Results {
+		contents = append(contents, result.Memo.Content)
+	}
+	require.ElementsMatch(t, []string{"alpha shared", "alpha followers"}, contents)
+}
+
+func TestUpdateMemoEtag(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	userCtx := withTestingUser(ctx, user)
+	memo, err := s.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "first", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+
+	// Every update changes the etag, even within the same second and without changing the content.
+	pinned, err := s.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Pinned: true, Etag: memo.Etag},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pinned"}},
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, memo.Etag, pinned.Etag)
+	relinked, err := s.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Etag: pinned.Etag},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"relations"}},
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, pinned.Etag, relinked.Etag)
+
+	// An update based on a stale etag is aborted with the current version.
+	_, err = s.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: "stale", Etag: memo.Etag},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	requireEtagMismatch(t, err, relinked)
+
+	// The write is conditional on the version read, a concurrent update in between aborts it.
+	memoUID, err := ExtractMemoUIDFromName(memo.Name)
+	require.NoError(t, err)
+	stored, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	require.NoError(t, err)
+	content := "concurrent"
+	require.NoError(t, s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: stored.ID, Content: &content, Version: &stored.Version}))
+	err = s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: stored.ID, Content: &content, Version: &stored.Version})
+	require.ErrorIs(t, err, store.ErrMemoVersionMismatch)
+	current, err := s.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, "concurrent", current.Content)
+	require.NotEqual(t, relinked.Etag, current.Etag)
+}
@@ -0,0 +1,156 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// memoShareTokenLength is the length of the secret token of a share link.
+const memoShareTokenLength = 32
+
+func (s *APIV1Service) CreateMemoShare(ctx context.Context, request *v1pb.CreateMemoShareRequest) (*v1pb.MemoShare, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.getMemoForCreatorAccess(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+	if memo.RowStatus == store.Trashed {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot share a memo in the trash")
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+
+	create := &store.MemoShare{
+		MemoID:    memo.ID,
+		CreatorID: user.ID,
+	}
+	if request.Share != nil {
+		if request.Share.ExpireTime != nil {
+			create.ExpiresTs = request.Share.ExpireTime.AsTime().Unix()
+			if create.ExpiresTs <= time.Now().Unix() {
+				return nil, status.Errorf(codes.InvalidArgument, "expire time must be in the future")
+			}
+		}
+		if request.Share.MaxViews < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "max views must not be negative")
+		}
+		create.MaxViews = request.Share.MaxViews
+	}
+	create.Token, err = util.RandomString(memoShareTokenLength)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate share token: %v", err)
+	}
+	memoShare, err := s.Store.CreateMemoShare(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create memo share: %v", err)
+	}
+	return convertMemoShareFromStore(memo, memoShare), nil
+}
+
+func (s *APIV1Service) ListMemoShares(ctx context.Context, request *v1pb.ListMemoSharesRequest) (*v1pb.ListMemoSharesResponse, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.getMemoForCreatorAccess(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	memoShares, err := s.Store.ListMemoShares(ctx, &store.FindMemoShare{MemoID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo shares: %v", err)
+	}
+	response := &v1pb.ListMemoSharesResponse{
+		Shares: []*v1pb.MemoShare{},
+	}
+	for _, memoShare := range memoShares {
+		response.Shares = append(response.Shares, convertMemoShareFromStore(memo, memoShare))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) RevokeMemoShare(ctx context.Context, request *v1pb.RevokeMemoShareRequest) (*emptypb.Empty, error) {
+	memoUID, memoShareID, err := ExtractMemoShareIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo share name: %v", err)
+	}
+	memo, err := s.getMemoForCreatorAccess(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	memoShare, err := s.Store.GetMemoShare(ctx, &store.FindMemoShare{ID: &memoShareID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo share: %v", err)
+	}
+	if memoShare == nil || memoShare.MemoID != memo.ID {
+		return nil, status.Errorf(codes.NotFound, "memo share not found")
+	}
+	if err := s.Store.DeleteMemoShare(ctx, &store.DeleteMemoShare{ID: &memoShare.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete memo share: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// viewMemoByShare checks the share link of the request grants access to the memo, and counts the view.
+func (s *APIV1Service) viewMemoByShare(ctx context.Context, memo *store.Memo) error {
+	memoShare, ok := ctx.Value(memoShareContextKey).(*store.MemoShare)
+	if !ok || memoShare.MemoID != memo.ID {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	viewed, err := s.Store.IncreaseMemoShareViewCount(ctx, memoShare.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to count memo share view: %v", err)
+	}
+	if !viewed {
+		return status.Errorf(codes.PermissionDenied, "the share link has reached its view limit")
+	}
+	return nil
+}
+
+// hasMemoShare returns whether the share link of the request grants access to the memo.
+// Unlike viewMemoByShare, it doesn't count a view, so the resources of the memo can be loaded along with it.
+func hasMemoShare(ctx context.Context, memoID int32) bool {
+	memoShare, ok := ctx.Value(memoShareContextKey).(*store.MemoShare)
+	return ok && memoShare.MemoID == memoID
+}
+
+// isMemoShareExpired returns whether the share link can no longer be used,
+// either because it has expired or because its views are used up.
+func isMemoShareExpired(memoShare *store.MemoShare) bool {
+	if memoShare.MaxViews > 0 && memoShare.ViewCount >= memoShare.MaxViews {
+		return true
+	}
+	return memoShare.ExpiresTs != 0 && memoShare.ExpiresTs <= time.Now().Unix()
+}
+
+func convertMemoShareFromStore(memo *store.Memo, memoShare *store.MemoShare) *v1pb.MemoShare {
+	share := &v1pb.MemoShare{
+		Name:       fmt.Sprintf("%s%s/%s%d", MemoNamePrefix, memo.UID, MemoShareNamePrefix, memoShare.ID),
+		Creator:    fmt.Sprintf("%s%d", UserNamePrefix, memoShare.CreatorID),
+		CreateTime: timestamppb.New(time.Unix(memoShare.CreatedTs, 0)),
+		Token:      memoShare.Token,
+		MaxViews:   memoShare.MaxViews,
+		ViewCount:  memoShare.ViewCount,
+	}
+	if memoShare.ExpiresTs != 0 {
+		share.ExpireTime = timestamppb.New(time.Unix(memoShare.ExpiresTs, 0))
+	}
+	return share
+}
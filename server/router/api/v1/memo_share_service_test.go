@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestAuthenticateMemoShareViewLimit(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "test", store.RoleHost)
+	memo, err := s.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "shared-memo",
+		CreatorID:  user.ID,
+		Content:    "shared",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	memoShare, err := s.Store.CreateMemoShare(ctx, &store.MemoShare{
+		MemoID:    memo.ID,
+		CreatorID: user.ID,
+		Token:     "test-token",
+		MaxViews:  1,
+	})
+	require.NoError(t, err)
+
+	interceptor := &GRPCAuthInterceptor{Store: s.Store}
+	request := &v1pb.GetResourceBinaryRequest{ShareToken: memoShare.Token}
+	fullMethod := "/memos.api.v1.ResourceService/GetResourceBinary"
+	shareCtx, err := interceptor.authenticateMemoShare(ctx, fullMethod, request)
+	require.NoError(t, err)
+	require.True(t, hasMemoShare(shareCtx, memo.ID))
+
+	// The token is rejected by every method once its views are used up.
+	require.NoError(t, s.viewMemoByShare(shareCtx, memo))
+	_, err = interceptor.authenticateMemoShare(ctx, fullMethod, request)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = interceptor.authenticateMemoShare(ctx, "/memos.api.v1.MemoService/GetMemo", &v1pb.GetMemoRequest{ShareToken: memoShare.Token})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,587 @@
+package v1
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"html/template"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/usememos/gomark/ast"
+	"github.com/usememos/gomark/parser"
+	"github.com/usememos/gomark/parser/tokenizer"
+	"github.com/usememos/gomark/renderer"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// staticSitePageSize is the number of memos of an index page of the static site.
+const staticSitePageSize = 20
+
+// StaticSiteWriter writes the files of the static site, e.g. to a ZIP or a directory.
+type StaticSiteWriter interface {
+	WriteFile(name string, reader io.Reader) error
+}
+
+// NewStaticSiteZipWriter returns a StaticSiteWriter that writes the files to the ZIP.
+func NewStaticSiteZipWriter(writer *zip.Writer) StaticSiteWriter {
+	return &staticSiteZipWriter{writer: writer}
+}
+
+// NewStaticSiteDirWriter returns a StaticSiteWriter that writes the files to the directory.
+func NewStaticSiteDirWriter(dir string) StaticSiteWriter {
+	return &staticSiteDirWriter{dir: dir}
+}
+
+type staticSiteZipWriter struct {
+	writer *zip.Writer
+}
+
+func (w *staticSiteZipWriter) WriteFile(name string, reader io.Reader) error {
+	file, err := w.writer.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(file, reader)
+	return err
+}
+
+type staticSiteDirWriter struct {
+	dir string
+}
+
+func (w *staticSiteDirWriter) WriteFile(name string, reader io.Reader) error {
+	filePath := filepath.Join(w.dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm); err != nil {
+		return err
+	}
+	file, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = io.Copy(file, reader)
+	return err
+}
+
+func (s *APIV1Service) ExportMemoSite(request *v1pb.ExportMemoSiteRequest, stream grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	ctx := stream.Context()
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID && !isSuperUser(currentUser) {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return status.Errorf(codes.NotFound, "user not found")
+	}
+
+	buffer := bufio.NewWriterSize(&httpBodyStreamWriter{stream: stream, contentType: "application/zip"}, exportArchiveChunkSize)
+	writer := zip.NewWriter(buffer)
+	if err := s.exportStaticSite(ctx, user, NewStaticSiteZipWriter(writer)); err != nil {
+		return status.Errorf(codes.Internal, "failed to export static site: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		return status.Errorf(codes.Internal, "failed to close zip writer: %v", err)
+	}
+	if err := buffer.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "failed to flush the site: %v", err)
+	}
+	return nil
+}
+
+// ExportStaticSite writes a static HTML site of the public memos of the user.
+// It's used by the export-site command, which runs without the API server.
+func ExportStaticSite(ctx context.Context, stores *store.Store, user *store.User, writer StaticSiteWriter) error {
+	s := &APIV1Service{Store: stores, Profile: stores.Profile}
+	return s.exportStaticSite(ctx, user, writer)
+}
+
+type staticSitePage struct {
+	// Root is the relative path from the page to the root of the site, e.g. "../".
+	Root      string
+	Title     string
+	SiteTitle string
+	Memos     []*staticSiteMemo
+	Tags      []*staticSiteTag
+	PrevURL   string
+	NextURL   string
+}
+
+type staticSiteMemo struct {
+	// URL is the path of the memo page from the root of the site.
+	URL        string
+	CreateTime string
+	HTML       template.HTML
+	Tags       []*staticSiteTag
+	Resources  []*staticSiteResource
+}
+
+type staticSiteTag struct {
+	Name string
+	URL  string
+}
+
+type staticSiteResource struct {
+	Filename string
+	// URL is the path of the file from the root of the site, or the link of an external resource.
+	URL      string
+	External bool
+	IsImage  bool
+}
+
+// staticSiteSearchEntry is an entry of the search index, search.json.
+type staticSiteSearchEntry struct {
+	URL        string   `json:"url"`
+	Snippet    string   `json:"snippet"`
+	Content    string   `json:"content"`
+	Tags       []string `json:"tags"`
+	CreateTime string   `json:"createTime"`
+}
+
+// exportStaticSite writes the site with the layout:
+//
+//	index.html, pages/{n}.html      the paginated index
+//	memos/{uid}.html                the memo pages
+//	tags/{tag}.html                 the memos of a tag and its sub tags
+//	resources/{uid}/{filename}      the resource files
+//	search.html, search.json        the search page and its index
+//	assets/style.css
+func (s *APIV1Service) exportStaticSite(ctx context.Context, user *store.User, writer StaticSiteWriter) error {
+	normalRowStatus := store.Normal
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &user.ID,
+		RowStatus:       &normalRowStatus,
+		VisibilityList:  []store.Visibility{store.Public},
+		ExcludeComments: true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memos")
+	}
+	slices.SortStableFunc(memos, func(a, b *store.Memo) int {
+		return int(b.CreatedTs - a.CreatedTs)
+	})
+	memoIDList := []int32{}
+	for _, memo := range memos {
+		memoIDList = append(memoIDList, memo.ID)
+	}
+	resources := []*store.Resource{}
+	if len(memoIDList) > 0 {
+		resources, err = s.Store.ListResources(ctx, &store.FindResource{
+			MemoIDList: memoIDList,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to list resources")
+		}
+	}
+	resourceMap := map[int32][]*store.Resource{}
+	for _, resource := range resources {
+		resourceMap[*resource.MemoID] = append(resourceMap[*resource.MemoID], resource)
+	}
+
+	siteTitle := user.Nickname
+	if siteTitle == "" {
+		siteTitle = user.Username
+	}
+	siteTitle += " · Memos"
+
+	tagMap := map[string]*staticSiteTag{}
+	siteMemos := []*staticSiteMemo{}
+	searchIndex := []*staticSiteSearchEntry{}
+	for _, memo := range memos {
+		siteMemo, searchEntry, err := s.buildStaticSiteMemo(ctx, writer, memo, resourceMap[memo.ID], tagMap)
+		if err != nil {
+			return err
+		}
+		siteMemos = append(siteMemos, siteMemo)
+		searchIndex = append(searchIndex, searchEntry)
+	}
+	tags := []*staticSiteTag{}
+	for _, tag := range tagMap {
+		tags = append(tags, tag)
+	}
+	slices.SortFunc(tags, func(a, b *staticSiteTag) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	// The memo pages.
+	for _, siteMemo := range siteMemos {
+		if err := writeStaticSitePage(writer, siteMemo.URL, staticSiteMemoTemplate, &staticSitePage{
+			Root:      "../",
+			Title:     siteMemo.CreateTime,
+			SiteTitle: siteTitle,
+			Memos:     []*staticSiteMemo{siteMemo},
+		}); err != nil {
+			return err
+		}
+	}
+
+	// The paginated index.
+	pageCount := max(1, (len(siteMemos)+staticSitePageSize-1)/staticSitePageSize)
+	for page := 1; page <= pageCount; page++ {
+		pageMemos := siteMemos[min((page-1)*staticSitePageSize, len(siteMemos)):min(page*staticSitePageSize, len(siteMemos))]
+		indexPage := &staticSitePage{
+			Root:      "",
+			Title:     siteTitle,
+			SiteTitle: siteTitle,
+			Memos:     pageMemos,
+			Tags:      tags,
+		}
+		if page > 1 {
+			indexPage.Root = "../"
+			indexPage.PrevURL = getStaticSiteIndexURL(page - 1)
+		}
+		if page < pageCount {
+			indexPage.NextURL = getStaticSiteIndexURL(page + 1)
+		}
+		if err := writeStaticSitePage(writer, getStaticSiteIndexURL(page), staticSiteIndexTemplate, indexPage); err != nil {
+			return err
+		}
+	}
+
+	// The tag pages, a tag page includes the memos of the sub tags.
+	for _, tag := range tags {
+		tagMemos := []*staticSiteMemo{}
+		for _, siteMemo := range siteMemos {
+			if slices.ContainsFunc(siteMemo.Tags, func(memoTag *staticSiteTag) bool {
+				return memoTag.Name == tag.Name || strings.HasPrefix(memoTag.Name, tag.Name+"/")
+			}) {
+				tagMemos = append(tagMemos, siteMemo)
+			}
+		}
+		if err := writeStaticSitePage(writer, tag.URL, staticSiteIndexTemplate, &staticSitePage{
+			Root:      strings.Repeat("../", strings.Count(tag.URL, "/")),
+			Title:     "#" + tag.Name,
+			SiteTitle: siteTitle,
+			Memos:     tagMemos,
+		}); err != nil {
+			return err
+		}
+	}
+
+	searchIndexJSON, err := json.Marshal(searchIndex)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal search index")
+	}
+	if err := writer.WriteFile("search.json", bytes.NewReader(searchIndexJSON)); err != nil {
+		return errors.Wrap(err, "failed to write search index")
+	}
+	if err := writeStaticSitePage(writer, "search.html", staticSiteSearchTemplate, &staticSitePage{
+		Title:     "Search",
+		SiteTitle: siteTitle,
+	}); err != nil {
+		return err
+	}
+	if err := writer.WriteFile("assets/style.css", strings.NewReader(staticSiteStyle)); err != nil {
+		return errors.Wrap(err, "failed to write style")
+	}
+	return nil
+}
+
+// buildStaticSiteMemo renders the memo and copies its resources, the tags of the memo are added to the tagMap.
+func (s *APIV1Service) buildStaticSiteMemo(ctx context.Context, writer StaticSiteWriter, memo *store.Memo, resources []*store.Resource, tagMap map[string]*staticSiteTag) (*staticSiteMemo, *staticSiteSearchEntry, error) {
+	nodes, err := parser.Parse(tokenizer.Tokenize(memo.Content))
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to parse memo %s", memo.UID)
+	}
+	createTime := time.Unix(memo.CreatedTs, 0).Format("2006-01-02 15:04")
+	siteMemo := &staticSiteMemo{
+		URL:        fmt.Sprintf("memos/%s.html", memo.UID),
+		CreateTime: createTime,
+		// The content is rendered by the gomark HTML renderer, which writes the text as is, so it's escaped first.
+		HTML:      template.HTML(renderer.NewHTMLRenderer().Render(escapeStaticSiteNodes(nodes))),
+		Tags:      []*staticSiteTag{},
+		Resources: []*staticSiteResource{},
+	}
+	for _, name := range memo.Payload.GetTags() {
+		// The parent tags get pages too, so the hierarchy can be browsed.
+		segments := strings.Split(name, "/")
+		for i := 1; i <= len(segments); i++ {
+			tagName := strings.Join(segments[:i], "/")
+			if _, ok := tagMap[tagName]; !ok {
+				tagMap[tagName] = &staticSiteTag{
+					Name: tagName,
+					URL:  getStaticSiteTagURL(tagName),
+				}
+			}
+		}
+		siteMemo.Tags = append(siteMemo.Tags, tagMap[name])
+	}
+
+	for _, resource := range resources {
+		siteResource := &staticSiteResource{
+			Filename: resource.Filename,
+			IsImage:  strings.HasPrefix(resource.Type, "image/"),
+		}
+		if resource.StorageType == storepb.ResourceStorageType_EXTERNAL || resource.StorageType == storepb.ResourceStorageType_S3 {
+			siteResource.URL = resource.Reference
+			siteResource.External = true
+		} else {
+			reader, err := s.openResourceBlob(ctx, resource)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to open resource %s", resource.UID)
+			}
+			if reader == nil {
+				continue
+			}
+			siteResource.URL = fmt.Sprintf("resources/%s/%s", resource.UID, sanitizeStaticSitePathSegment(filepath.Base(resource.Filename)))
+			err = writer.WriteFile(siteResource.URL, reader)
+			reader.Close()
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to write resource %s", resource.UID)
+			}
+		}
+		siteMemo.Resources = append(siteMemo.Resources, siteResource)
+	}
+
+	snippet, err := getMemoContentSnippet(memo.Content)
+	if err != nil {
+		return nil, nil, err
+	}
+	searchEntry := &staticSiteSearchEntry{
+		URL:        siteMemo.URL,
+		Snippet:    strings.TrimSpace(snippet),
+		Content:    strings.TrimSpace(renderer.NewStringRenderer().Render(nodes)),
+		Tags:       memo.Payload.GetTags(),
+		CreateTime: createTime,
+	}
+	if searchEntry.Tags == nil {
+		searchEntry.Tags = []string{}
+	}
+	return siteMemo, searchEntry, nil
+}
+
+// escapeStaticSiteNodes escapes the text and the URLs of the nodes in place, so the memos can't inject
+// the HTML or the scripts into the site. The URLs with the other schemes than http, https and mailto are dropped.
+func escapeStaticSiteNodes(nodes []ast.Node) []ast.Node {
+	for _, node := range nodes {
+		switch n := node.(type) {
+		case *ast.Paragraph:
+			escapeStaticSiteNodes(n.Children)
+		case *ast.Heading:
+			escapeStaticSiteNodes(n.Children)
+		case *ast.Blockquote:
+			escapeStaticSiteNodes(n.Children)
+		case *ast.List:
+			escapeStaticSiteNodes(n.Children)
+		case *ast.OrderedListItem:
+			escapeStaticSiteNodes(n.Children)
+		case *ast.UnorderedListItem:
+			escapeStaticSiteNodes(n.Children)
+		case *ast.TaskListItem:
+			escapeStaticSiteNodes(n.Children)
+		case *ast.Bold:
+			escapeStaticSiteNodes(n.Children)
+		case *ast.Table:
+			escapeStaticSiteNodes(n.Header)
+			for _, row := range n.Rows {
+				escapeStaticSiteNodes(row)
+			}
+		case *ast.CodeBlock:
+			n.Content = html.EscapeString(n.Content)
+		case *ast.MathBlock:
+			n.Content = html.EscapeString(n.Content)
+		case *ast.EmbeddedContent:
+			n.ResourceName, n.Params = html.EscapeString(n.ResourceName), html.EscapeString(n.Params)
+		case *ast.ReferencedContent:
+			n.ResourceName, n.Params = html.EscapeString(n.ResourceName), html.EscapeString(n.Params)
+		case *ast.Text:
+			n.Content = html.EscapeString(n.Content)
+		case *ast.Italic:
+			n.Content = html.EscapeString(n.Content)
+		case *ast.BoldItalic:
+			n.Content = html.EscapeString(n.Content)
+		case *ast.Code:
+			n.Content = html.EscapeString(n.Content)
+		case *ast.Tag:
+			n.Content = html.EscapeString(n.Content)
+		case *ast.Strikethrough:
+			n.Content = html.EscapeString(n.Content)
+		case *ast.Math:
+			n.Content = html.EscapeString(n.Content)
+		case *ast.Highlight:
+			n.Content = html.EscapeString(n.Content)
+		case *ast.Subscript:
+			n.Content = html.EscapeString(n.Content)
+		case *ast.Superscript:
+			n.Content = html.EscapeString(n.Content)
+		case *ast.Spoiler:
+			n.Content = html.EscapeString(n.Content)
+		case *ast.EscapingCharacter:
+			n.Symbol = html.EscapeString(n.Symbol)
+		case *ast.HTMLElement:
+			n.TagName = html.EscapeString(n.TagName)
+		case *ast.Image:
+			n.AltText, n.URL = html.EscapeString(n.AltText), escapeStaticSiteURL(n.URL)
+		case *ast.Link:
+			n.Text, n.URL = html.EscapeString(n.Text), escapeStaticSiteURL(n.URL)
+		case *ast.AutoLink:
+			n.URL = escapeStaticSiteURL(n.URL)
+		}
+	}
+	return nodes
+}
+
+// escapeStaticSiteURL escapes the URL of a link, the URLs with the unsafe schemes, e.g. javascript, become "#".
+func escapeStaticSiteURL(rawURL string) string {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || !slices.Contains([]string{"", "http", "https", "mailto"}, strings.ToLower(u.Scheme)) {
+		return "#"
+	}
+	return html.EscapeString(rawURL)
+}
+
+func writeStaticSitePage(writer StaticSiteWriter, name string, pageTemplate *template.Template, page *staticSitePage) error {
+	buffer := &bytes.Buffer{}
+	if err := pageTemplate.ExecuteTemplate(buffer, "layout", page); err != nil {
+		return errors.Wrapf(err, "failed to render %s", name)
+	}
+	if err := writer.WriteFile(name, buffer); err != nil {
+		return errors.Wrapf(err, "failed to write %s", name)
+	}
+	return nil
+}
+
+func getStaticSiteIndexURL(page int) string {
+	if page == 1 {
+		return "index.html"
+	}
+	return fmt.Sprintf("pages/%d.html", page)
+}
+
+// getStaticSiteTagURL returns the path of the tag page, the sub tags are nested in folders, e.g. "tags/a/b.html".
+func getStaticSiteTagURL(tag string) string {
+	segments := []string{}
+	for _, segment := range strings.Split(tag, "/") {
+		segments = append(segments, sanitizeStaticSitePathSegment(segment))
+	}
+	return path.Join("tags", path.Join(segments...)) + ".html"
+}
+
+// staticSitePathRegexp matches the characters that are invalid in file names or break the relative URLs.
+var staticSitePathRegexp = regexp.MustCompile(`[<>"\\/:|?*#%\s]`)
+
+func sanitizeStaticSitePathSegment(segment string) string {
+	segment = staticSitePathRegexp.ReplaceAllString(segment, "-")
+	if segment == "" || segment == "." || segment == ".." {
+		return "-"
+	}
+	return segment
+}
+
+// staticSiteMemoView is the data of the "memo" template, which needs the root of the page to link the files.
+type staticSiteMemoView struct {
+	Root string
+	Memo *staticSiteMemo
+}
+
+func newStaticSiteTemplate(content string) *template.Template {
+	funcs := template.FuncMap{
+		"memoView": func(root string, memo *staticSiteMemo) *staticSiteMemoView {
+			return &staticSiteMemoView{Root: root, Memo: memo}
+		},
+	}
+	return template.Must(template.Must(template.New("layout").Funcs(funcs).Parse(staticSiteLayout)).Parse(content))
+}
+
+var (
+	staticSiteIndexTemplate = newStaticSiteTemplate(`{{define "content"}}
+{{if .Tags}}<nav class="tags">{{range .Tags}}<a href="{{$.Root}}{{.URL}}">#{{.Name}}</a> {{end}}</nav>{{end}}
+<h1>{{.Title}}</h1>
+{{range .Memos}}{{template "memo" memoView $.Root .}}{{else}}<p>No memos.</p>{{end}}
+<nav class="pagination">{{if .PrevURL}}<a href="{{.Root}}{{.PrevURL}}">Newer</a>{{end}} {{if .NextURL}}<a href="{{.Root}}{{.NextURL}}">Older</a>{{end}}</nav>
+{{end}}`)
+	staticSiteMemoTemplate = newStaticSiteTemplate(`{{define "content"}}{{range .Memos}}{{template "memo" memoView $.Root .}}{{end}}{{end}}`)
+	// The search page loads the index with fetch, so it works when the site is served over HTTP.
+	staticSiteSearchTemplate = newStaticSiteTemplate(`{{define "content"}}
+<h1>Search</h1>
+<input id="search" type="search" placeholder="Search memos" autofocus>
+<ul id="results"></ul>
+<script>
+fetch("search.json").then((response) => response.json()).then((entries) => {
+  const input = document.getElementById("search");
+  const results = document.getElementById("results");
+  input.addEventListener("input", () => {
+    const words = input.value.toLowerCase().split(/\s+/).filter(Boolean);
+    results.replaceChildren();
+    if (words.length === 0) return;
+    for (const entry of entries) {
+      const text = (entry.content + " " + entry.tags.join(" ")).toLowerCase();
+      if (!words.every((word) => text.includes(word))) continue;
+      const item = document.createElement("li");
+      const link = document.createElement("a");
+      link.href = entry.url;
+      link.textContent = entry.createTime + " " + entry.snippet;
+      item.appendChild(link);
+      results.appendChild(item);
+    }
+  });
+});
+</script>
+{{end}}`)
+)
+
+const staticSiteLayout = `{{define "layout"}}<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<link rel="stylesheet" href="{{.Root}}assets/style.css">
+</head>
+<body>
+<header><a href="{{.Root}}index.html">{{.SiteTitle}}</a> <a href="{{.Root}}search.html">Search</a></header>
+<main>{{template "content" .}}</main>
+</body>
+</html>
+{{end}}
+{{define "memo"}}<article class="memo">
+<a class="time" href="{{.Root}}{{.Memo.URL}}">{{.Memo.CreateTime}}</a>
+<div class="content">{{.Memo.HTML}}</div>
+{{range .Memo.Resources}}{{if .IsImage}}<img src="{{if not .External}}{{$.Root}}{{end}}{{.URL}}" alt="{{.Filename}}">{{else}}<a class="resource" href="{{if not .External}}{{$.Root}}{{end}}{{.URL}}">{{.Filename}}</a>{{end}}
+{{end}}{{if .Memo.Tags}}<div class="tags">{{range .Memo.Tags}}<a href="{{$.Root}}{{.URL}}">#{{.Name}}</a> {{end}}</div>{{end}}
+</article>
+{{end}}`
+
+const staticSiteStyle = `body { max-width: 720px; margin: 0 auto; padding: 16px; font-family: sans-serif; line-height: 1.6; color: #222; }
+header { display: flex; gap: 16px; padding-bottom: 16px; border-bottom: 1px solid #ddd; }
+a { color: #0b63c5; text-decoration: none; }
+.memo { padding: 16px 0; border-bottom: 1px solid #eee; }
+.memo .time { color: #888; font-size: 14px; }
+.memo img { max-width: 100%; display: block; margin: 8px 0; }
+.memo .resource { display: block; }
+.tags a { margin-right: 8px; font-size: 14px; }
+.pagination { display: flex; justify-content: space-between; padding: 16px 0; }
+#search { width: 100%; padding: 8px; font-size: 16px; }
+`
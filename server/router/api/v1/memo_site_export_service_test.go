@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestExportMemoSite(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	otherUser := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	userCtx := withTestingUser(ctx, user)
+	publicMemo, err := s.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "public #travel", Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+	privateMemo, err := s.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "private", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+	parent := fmt.Sprintf("%s%d", UserNamePrefix, user.ID)
+
+	// Anonymous users and other users can't export the site of the user.
+	err = s.ExportMemoSite(&v1pb.ExportMemoSiteRequest{Parent: parent}, &testingHTTPBodyStream{ctx: ctx})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	err = s.ExportMemoSite(&v1pb.ExportMemoSiteRequest{Parent: parent}, &testingHTTPBodyStream{ctx: withTestingUser(ctx, otherUser)})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The site has the pages of the public memos only.
+	stream := &testingHTTPBodyStream{ctx: userCtx}
+	err = s.ExportMemoSite(&v1pb.ExportMemoSiteRequest{Parent: parent}, stream)
+	require.NoError(t, err)
+	files := readTestingZip(t, stream.buffer.Bytes())
+	for _, name := range []string{"index.html", "search.html", "search.json", "assets/style.css", getStaticSiteTagURL("travel")} {
+		require.Contains(t, files, name)
+	}
+	publicUID, err := ExtractMemoUIDFromName(publicMemo.Name)
+	require.NoError(t, err)
+	privateUID, err := ExtractMemoUIDFromName(privateMemo.Name)
+	require.NoError(t, err)
+	require.Contains(t, files, fmt.Sprintf("memos/%s.html", publicUID))
+	require.NotContains(t, files, fmt.Sprintf("memos/%s.html", privateUID))
+	searchIndex := []*staticSiteSearchEntry{}
+	require.NoError(t, json.Unmarshal(files["search.json"], &searchIndex))
+	require.Equal(t, 1, len(searchIndex))
+	require.Equal(t, []string{"travel"}, searchIndex[0].Tags)
+}
+
+func TestExportMemoSiteEscapesContent(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	userCtx := withTestingUser(ctx, user)
+	memo, err := s.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{
+		Content:    "<script>alert(1)</script> <x\" onmouseover=\"alert(1)> `<b>code</b>` [link](javascript:alert(1)) [site](https://usememos.com)",
+		Visibility: v1pb.Visibility_PUBLIC,
+	}})
+	require.NoError(t, err)
+
+	// The HTML in the memos is shown as text, and the links with the unsafe schemes are dropped.
+	stream := &testingHTTPBodyStream{ctx: userCtx}
+	err = s.ExportMemoSite(&v1pb.ExportMemoSiteRequest{Parent: fmt.Sprintf("%s%d", UserNamePrefix, user.ID)}, stream)
+	require.NoError(t, err)
+	files := readTestingZip(t, stream.buffer.Bytes())
+	uid, err := ExtractMemoUIDFromName(memo.Name)
+	require.NoError(t, err)
+	page := string(files[fmt.Sprintf("memos/%s.html", uid)])
+	require.NotContains(t, page, "<script>")
+	require.NotContains(t, page, "<b>")
+	require.NotContains(t, page, `" onmouseover="`)
+	require.NotContains(t, page, "javascript:")
+	require.Contains(t, page, `<a href="https://usememos.com">site</a>`)
+}
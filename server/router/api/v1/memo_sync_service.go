@@ -0,0 +1,198 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// DefaultSyncPageSize is the default number of memos returned by a sync.
+	DefaultSyncPageSize = 100
+	// MaxSyncPageSize is the maximum number of memos returned by a sync.
+	MaxSyncPageSize = 1000
+	// syncTokenOverlap is how far back, in seconds, the next sync starts before the previous one.
+	// The changes committed late within the same seconds are synced again rather than missed.
+	syncTokenOverlap = 5
+)
+
+// SyncMemos returns the changes of the current user's memos, comments and resources since the sync token.
+// The memos are synced by their change time, which unlike their update time can't be set by the clients,
+// the resources by their update time, and the removed ones by their tombstones.
+func (s *APIV1Service) SyncMemos(ctx context.Context, request *v1pb.SyncMemosRequest) (*v1pb.SyncMemosResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	syncToken := &v1pb.SyncToken{}
+	if request.SyncToken != "" {
+		if err := unmarshalSyncToken(request.SyncToken, syncToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sync token: %v", err)
+		}
+	}
+	pageSize := int(request.PageSize)
+	if pageSize <= 0 {
+		pageSize = DefaultSyncPageSize
+	}
+	if pageSize > MaxSyncPageSize {
+		pageSize = MaxSyncPageSize
+	}
+	// The first page of a sync starts now, the following pages continue with the start of the first one.
+	startTs := time.Now().Unix()
+	if syncToken.Cursor != nil {
+		startTs = syncToken.StartTs
+	}
+	// The changes at the time of the token are included, the same change may be synced twice but never missed.
+	changedTsAfter := syncToken.Since - 1
+
+	normalStatus := store.Normal
+	limitPlusOne := pageSize + 1
+	memoFind := &store.FindMemo{
+		CreatorID:        &user.ID,
+		RowStatus:        &normalStatus,
+		ChangedTsAfter:   &changedTsAfter,
+		OrderByChangedTs: true,
+		OrderByTimeAsc:   true,
+		Limit:            &limitPlusOne,
+	}
+	if cursor := syncToken.Cursor; cursor != nil {
+		memoFind.Cursor = &store.Cursor{
+			ID: cursor.Id,
+			Ts: cursor.Ts,
+		}
+	}
+	memos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	hasMore := len(memos) > pageSize
+	if hasMore {
+		memos = memos[:pageSize]
+	}
+	memoMessages, err := s.convertMemosFromStore(ctx, memos)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memos")
+	}
+	response := &v1pb.SyncMemosResponse{
+		Memos:   memoMessages,
+		HasMore: hasMore,
+	}
+
+	// The resources and the tombstones are synced with the first page.
+	if syncToken.Cursor == nil {
+		resources, err := s.listSyncResources(ctx, user.ID, changedTsAfter)
+		if err != nil {
+			return nil, err
+		}
+		response.Resources = resources
+		// There is nothing to remove on the first sync.
+		if syncToken.Since > 0 {
+			tombstones, err := s.Store.ListTombstones(ctx, &store.FindTombstone{
+				CreatorID:      &user.ID,
+				CreatedTsAfter: &changedTsAfter,
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to list tombstones: %v", err)
+			}
+			for _, tombstone := range tombstones {
+				response.Tombstones = append(response.Tombstones, convertTombstoneFromStore(tombstone))
+			}
+		}
+	}
+
+	nextSyncToken := &v1pb.SyncToken{
+		Since: startTs - syncTokenOverlap,
+	}
+	if hasMore {
+		lastMemo := memos[len(memos)-1]
+		nextSyncToken = &v1pb.SyncToken{
+			Since: syncToken.Since,
+			Cursor: &v1pb.PageToken_Cursor{
+				Id: lastMemo.ID,
+				Ts: lastMemo.ChangedTs,
+			},
+			StartTs: startTs,
+		}
+	}
+	if response.SyncToken, err = marshalSyncToken(nextSyncToken); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal sync token: %v", err)
+	}
+	return response, nil
+}
+
+// listSyncResources lists the resources of the user updated after the time, except the ones of the memos in the trash.
+func (s *APIV1Service) listSyncResources(ctx context.Context, userID int32, updatedTsAfter int64) ([]*v1pb.Resource, error) {
+	resources, err := s.Store.ListResources(ctx, &store.FindResource{
+		CreatorID:      &userID,
+		UpdatedTsAfter: &updatedTsAfter,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list resources: %v", err)
+	}
+	memoIDList := []int32{}
+	for _, resource := range resources {
+		if resource.MemoID != nil {
+			memoIDList = append(memoIDList, *resource.MemoID)
+		}
+	}
+	memoMap := map[int32]*store.Memo{}
+	if len(memoIDList) > 0 {
+		memos, err := s.Store.ListMemos(ctx, &store.FindMemo{IDList: memoIDList, ExcludeContent: true})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+		}
+		for _, memo := range memos {
+			memoMap[memo.ID] = memo
+		}
+	}
+
+	resourceMessages := []*v1pb.Resource{}
+	for _, resource := range resources {
+		var memo *store.Memo
+		if resource.MemoID != nil {
+			memo = memoMap[*resource.MemoID]
+			if memo != nil && memo.RowStatus == store.Trashed {
+				continue
+			}
+		}
+		resourceMessages = append(resourceMessages, convertResourceFromStoreWithMemo(resource, memo))
+	}
+	return resourceMessages, nil
+}
+
+// touchMemo bumps the update time of the memo, so the change of its resources or relations is synced.
+func (s *APIV1Service) touchMemo(ctx context.Context, memoID int32) error {
+	updatedTs := time.Now().Unix()
+	return s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:        memoID,
+		UpdatedTs: &updatedTs,
+	})
+}
+
+func convertTombstoneFromStore(tombstone *store.Tombstone) *v1pb.Tombstone {
+	namePrefix := MemoNamePrefix
+	if tombstone.Type == store.TombstoneTypeResource {
+		namePrefix = ResourceNamePrefix
+	}
+	reason := v1pb.Tombstone_DELETED
+	if tombstone.Reason == store.TombstoneReasonArchived {
+		reason = v1pb.Tombstone_ARCHIVED
+	}
+	return &v1pb.Tombstone{
+		Name:       fmt.Sprintf("%s%s", namePrefix, tombstone.UID),
+		Reason:     reason,
+		DeleteTime: timestamppb.New(time.Unix(tombstone.CreatedTs, 0)),
+	}
+}
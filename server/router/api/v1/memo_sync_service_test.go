@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestSyncMemosWithClientUpdateTime(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	userCtx := withTestingUser(ctx, user)
+	memo, err := s.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "first", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+	response, err := s.SyncMemos(userCtx, &v1pb.SyncMemosRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(response.Memos))
+
+	// The update time set by the client is in the past of the sync token, the change is synced anyway.
+	past := timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	_, err = s.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: "second", UpdateTime: past},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content", "update_time"}},
+	})
+	require.NoError(t, err)
+	response, err = s.SyncMemos(userCtx, &v1pb.SyncMemosRequest{SyncToken: response.SyncToken})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(response.Memos))
+	require.Equal(t, "second", response.Memos[0].Content)
+	require.Equal(t, past.AsTime().Unix(), response.Memos[0].UpdateTime.AsTime().Unix())
+
+	// So is the display time, which is the update time when the memos are displayed with it.
+	memoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	require.NoError(t, err)
+	memoRelatedSetting.DisplayWithUpdateTime = true
+	_, err = s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_MEMO_RELATED,
+		Value: &storepb.WorkspaceSetting_MemoRelatedSetting{MemoRelatedSetting: memoRelatedSetting},
+	})
+	require.NoError(t, err)
+	_, err = s.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, DisplayTime: timestamppb.New(past.AsTime().Add(time.Hour))},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_time"}},
+	})
+	require.NoError(t, err)
+	response, err = s.SyncMemos(userCtx, &v1pb.SyncMemosRequest{SyncToken: response.SyncToken})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(response.Memos))
+	require.Equal(t, past.AsTime().Add(time.Hour).Unix(), response.Memos[0].UpdateTime.AsTime().Unix())
+}
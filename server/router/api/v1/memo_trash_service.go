@@ -0,0 +1,154 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListTrashedMemos(ctx context.Context, request *v1pb.ListTrashedMemosRequest) (*v1pb.ListTrashedMemosResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+
+	trashedStatus := store.Trashed
+	memoFind := &store.FindMemo{
+		CreatorID: &user.ID,
+		RowStatus: &trashedStatus,
+		// Comments trashed together with their memo are restored and purged with it.
+		ExcludeTrashedWithParent: true,
+	}
+	var limit int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		memoFind.Cursor = convertCursorFromPageToken(&pageToken)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limitPlusOne := limit + 1
+	memoFind.Limit = &limitPlusOne
+	memos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(memos) == limitPlusOne {
+		memos = memos[:limit]
+		lastMemo := memos[len(memos)-1]
+		nextPageToken, err = getCursorPageToken(limit, &store.Cursor{
+			ID: lastMemo.ID,
+			Ts: lastMemo.CreatedTs,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+	memoMessages, err := s.convertMemosFromStore(ctx, memos)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memos")
+	}
+
+	return &v1pb.ListTrashedMemosResponse{
+		Memos:         memoMessages,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *APIV1Service) RestoreMemo(ctx context.Context, request *v1pb.RestoreMemoRequest) (*v1pb.Memo, error) {
+	memo, err := s.getTrashedMemo(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	trashedWithParent, err := s.isTrashedWithParent(ctx, memo)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get parent memo: %v", err)
+	}
+	if trashedWithParent {
+		return nil, status.Errorf(codes.FailedPrecondition, "restore the parent memo instead")
+	}
+
+	if err := s.Store.RestoreMemo(ctx, memo); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore memo: %v", err)
+	}
+	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	memoMessage, err := s.convertMemoFromStore(ctx, memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memo")
+	}
+	s.publishMemoEvent(ctx, v1pb.Event_MEMO_UPDATED, memo, memoMessage, nil)
+	return memoMessage, nil
+}
+
+func (s *APIV1Service) PurgeMemo(ctx context.Context, request *v1pb.PurgeMemoRequest) (*emptypb.Empty, error) {
+	memo, err := s.getTrashedMemo(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.PurgeMemo(ctx, memo); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to purge memo: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getTrashedMemo returns the memo in the trash that the current user can restore or purge.
+func (s *APIV1Service) getTrashedMemo(ctx context.Context, name string) (*store.Memo, error) {
+	memoUID, err := ExtractMemoUIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	// Only the creator or admin can restore or purge the memo.
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if memo.RowStatus != store.Trashed {
+		return nil, status.Errorf(codes.FailedPrecondition, "memo is not in the trash")
+	}
+	return memo, nil
+}
+
+// canSeeTrashedMemo returns whether the user can see the memo in the trash, and its resources.
+// Only the creator or admin can.
+func canSeeTrashedMemo(user *store.User, memo *store.Memo) bool {
+	return user != nil && (memo.CreatorID == user.ID || isSuperUser(user))
+}
+
+// isTrashedWithParent returns whether the memo is a comment moved to the trash together with its parent memo.
+func (s *APIV1Service) isTrashedWithParent(ctx context.Context, memo *store.Memo) (bool, error) {
+	if memo.ParentID == nil {
+		return false, nil
+	}
+	parent, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: memo.ParentID, ExcludeContent: true})
+	if err != nil {
+		return false, err
+	}
+	return parent != nil && parent.RowStatus == store.Trashed && parent.TrashedTs == memo.TrashedTs, nil
+}
@@ -0,0 +1,45 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestListTrashedMemosPaging(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	userCtx := withTestingUser(ctx, user)
+	names := []string{}
+	for _, content := range []string{"first", "second"} {
+		memo, err := s.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content}})
+		require.NoError(t, err)
+		for i := 0; i < 2; i++ {
+			_, err := s.CreateMemoComment(userCtx, &v1pb.CreateMemoCommentRequest{Name: memo.Name, Comment: &v1pb.Memo{Content: "comment"}})
+			require.NoError(t, err)
+		}
+		_, err = s.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		names = append(names, memo.Name)
+	}
+
+	// The comments trashed with their memos don't take the places of the memos in the pages.
+	listed := []string{}
+	pageToken := ""
+	for {
+		response, err := s.ListTrashedMemos(userCtx, &v1pb.ListTrashedMemosRequest{PageSize: 1, PageToken: pageToken})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(response.Memos))
+		listed = append(listed, response.Memos[0].Name)
+		if response.NextPageToken == "" {
+			break
+		}
+		pageToken = response.NextPageToken
+	}
+	require.ElementsMatch(t, names, listed)
+}
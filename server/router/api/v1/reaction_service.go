@@ -38,6 +38,22 @@ func (s *APIV1Service) UpsertMemoReaction(ctx context.Context, request *v1pb.Ups
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user")
 	}
+	// The users blocked by the creator of the memo can't react to it.
+	if memoUID, err := ExtractMemoUIDFromName(request.Reaction.ContentId); err == nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo")
+		}
+		if memo != nil {
+			blocked, err := s.isBlockedByUser(ctx, memo.CreatorID, user.ID)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to check user block: %v", err)
+			}
+			if blocked {
+				return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+			}
+		}
+	}
 	reaction, err := s.Store.UpsertReaction(ctx, &store.Reaction{
 		CreatorID:    user.ID,
 		ContentID:    request.Reaction.ContentId,
@@ -51,15 +67,29 @@ func (s *APIV1Service) UpsertMemoReaction(ctx context.Context, request *v1pb.Ups
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to convert reaction")
 	}
+	s.publishReactionEvent(ctx, v1pb.Event_REACTION_UPSERTED, reactionMessage)
 	return reactionMessage, nil
 }
 
 func (s *APIV1Service) DeleteMemoReaction(ctx context.Context, request *v1pb.DeleteMemoReactionRequest) (*emptypb.Empty, error) {
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{
+		ID: &request.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list reactions")
+	}
 	if err := s.Store.DeleteReaction(ctx, &store.DeleteReaction{
 		ID: request.Id,
 	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete reaction")
 	}
+	for _, reaction := range reactions {
+		reactionMessage, err := s.convertReactionFromStore(ctx, reaction)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert reaction")
+		}
+		s.publishReactionEvent(ctx, v1pb.Event_REACTION_DELETED, reactionMessage)
+	}
 
 	return &emptypb.Empty{}, nil
 }
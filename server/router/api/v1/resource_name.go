@@ -17,6 +17,12 @@ const (
 	InboxNamePrefix            = "inboxes/"
 	IdentityProviderNamePrefix = "identityProviders/"
 	ActivityNamePrefix         = "activities/"
+	MemoRevisionNamePrefix     = "revisions/"
+	MemoShareNamePrefix        = "shares/"
+	ChannelNamePrefix          = "channels/"
+	MessageNamePrefix          = "messages/"
+	FollowRequestNamePrefix    = "followRequests/"
+	GroupNamePrefix            = "groups/"
 )
 
 // GetNameParentTokens returns the tokens from a resource name.
@@ -71,6 +77,34 @@ func ExtractMemoUIDFromName(name string) (string, error) {
 	return id, nil
 }
 
+// ExtractMemoRevisionIDFromName returns the memo UID and revision ID from a resource name.
+// e.g., "memos/uuid/revisions/1" -> "uuid", 1.
+func ExtractMemoRevisionIDFromName(name string) (string, int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, MemoRevisionNamePrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return "", 0, errors.Errorf("invalid memo revision ID %q", tokens[1])
+	}
+	return tokens[0], id, nil
+}
+
+// ExtractMemoShareIDFromName returns the memo UID and share ID from a resource name.
+// e.g., "memos/uuid/shares/1" -> "uuid", 1.
+func ExtractMemoShareIDFromName(name string) (string, int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, MemoShareNamePrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return "", 0, errors.Errorf("invalid memo share ID %q", tokens[1])
+	}
+	return tokens[0], id, nil
+}
+
 // ExtractResourceUIDFromName returns the resource UID from a resource name.
 func ExtractResourceUIDFromName(name string) (string, error) {
 	tokens, err := GetNameParentTokens(name, ResourceNamePrefix)
@@ -117,3 +151,42 @@ func ExtractActivityIDFromName(name string) (int32, error) {
 	}
 	return id, nil
 }
+
+// ExtractChannelIDFromName returns the channel ID from a resource name.
+func ExtractChannelIDFromName(name string) (int32, error) {
+	tokens, err := GetNameParentTokens(name, ChannelNamePrefix)
+	if err != nil {
+		return 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, errors.Errorf("invalid channel ID %q", tokens[0])
+	}
+	return id, nil
+}
+
+// ExtractFollowRequestIDFromName returns the follow request ID from a resource name.
+func ExtractFollowRequestIDFromName(name string) (int32, error) {
+	tokens, err := GetNameParentTokens(name, FollowRequestNamePrefix)
+	if err != nil {
+		return 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, errors.Errorf("invalid follow request ID %q", tokens[0])
+	}
+	return id, nil
+}
+
+// ExtractGroupIDFromName returns the group ID from a resource name.
+func ExtractGroupIDFromName(name string) (int32, error) {
+	tokens, err := GetNameParentTokens(name, GroupNamePrefix)
+	if err != nil {
+		return 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, errors.Errorf("invalid group ID %q", tokens[0])
+	}
+	return id, nil
+}
@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -45,68 +46,77 @@ var SupportedThumbnailMimeTypes = []string{
 }
 
 func (s *APIV1Service) CreateResource(ctx context.Context, request *v1pb.CreateResourceRequest) (*v1pb.Resource, error) {
-	user, err := s.GetCurrentUser(ctx)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
-	}
-
-	create := &store.Resource{
-		UID:       shortuuid.New(),
-		CreatorID: user.ID,
-		Filename:  request.Resource.Filename,
-		Type:      request.Resource.Type,
-	}
+	return runIdempotently(ctx, s, v1pb.ResourceService_CreateResource_FullMethodName, func(record recordIdempotencyKey) (*v1pb.Resource, error) {
+		user, err := s.GetCurrentUser(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+		}
 
-	workspaceStorageSetting, err := s.Store.GetWorkspaceStorageSetting(ctx)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get workspace storage setting: %v", err)
-	}
-	size := binary.Size(request.Resource.Content)
-	uploadSizeLimit := int(workspaceStorageSetting.UploadSizeLimitMb) * MebiByte
-	if uploadSizeLimit == 0 {
-		uploadSizeLimit = MaxUploadBufferSizeBytes
-	}
-	if size > uploadSizeLimit {
-		return nil, status.Errorf(codes.InvalidArgument, "file size exceeds the limit")
-	}
-	create.Size = int64(size)
-	create.Blob = request.Resource.Content
-	if err := SaveResourceBlob(ctx, s.Store, create); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to save resource blob: %v", err)
-	}
+		create := &store.Resource{
+			UID:       shortuuid.New(),
+			CreatorID: user.ID,
+			Filename:  request.Resource.Filename,
+			Type:      request.Resource.Type,
+		}
 
-	if request.Resource.Memo != nil {
-		memoUID, err := ExtractMemoUIDFromName(*request.Resource.Memo)
+		workspaceStorageSetting, err := s.Store.GetWorkspaceStorageSetting(ctx)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to get workspace storage setting: %v", err)
 		}
-		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to find memo: %v", err)
+		size := binary.Size(request.Resource.Content)
+		uploadSizeLimit := int(workspaceStorageSetting.UploadSizeLimitMb) * MebiByte
+		if uploadSizeLimit == 0 {
+			uploadSizeLimit = MaxUploadBufferSizeBytes
+		}
+		if size > uploadSizeLimit {
+			return nil, status.Errorf(codes.InvalidArgument, "file size exceeds the limit")
+		}
+		create.Size = int64(size)
+		create.Blob = request.Resource.Content
+		if err := SaveResourceBlob(ctx, s.Store, create); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to save resource blob: %v", err)
 		}
-		create.MemoID = &memo.ID
-	}
-	resource, err := s.Store.CreateResource(ctx, create)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create resource: %v", err)
-	}
 
-	return s.convertResourceFromStore(ctx, resource), nil
+		if request.Resource.Memo != nil {
+			memoUID, err := ExtractMemoUIDFromName(*request.Resource.Memo)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+			}
+			memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to find memo: %v", err)
+			}
+			create.MemoID = &memo.ID
+		}
+		var resource *store.Resource
+		if err := s.withTx(ctx, func(tx *APIV1Service) error {
+			var err error
+			if resource, err = tx.Store.CreateResource(ctx, create); err != nil {
+				return status.Errorf(codes.Internal, "failed to create resource: %v", err)
+			}
+			return record(tx, fmt.Sprintf("%s%s", ResourceNamePrefix, resource.UID))
+		}); err != nil {
+			return nil, err
+		}
+
+		return s.convertResourceFromStore(ctx, resource), nil
+	}, func(name string) (*v1pb.Resource, error) {
+		return s.GetResource(ctx, &v1pb.GetResourceRequest{Name: name})
+	})
 }
 
 func (s *APIV1Service) ListResources(ctx context.Context, request *v1pb.ListResourcesRequest) (*v1pb.ListResourcesResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
 	var userID int32
-	var err error
 	if request.Parent != "" && request.Parent != "users/-" {
 		userID, err = ExtractUserIDFromName(request.Parent)
 		if err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
 		}
 	} else {
-		user, err := s.GetCurrentUser(ctx)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
-		}
 		userID = user.ID
 	}
 	resources, err := s.Store.ListResources(ctx, &store.FindResource{
@@ -118,6 +128,16 @@ func (s *APIV1Service) ListResources(ctx context.Context, request *v1pb.ListReso
 
 	response := &v1pb.ListResourcesResponse{}
 	for _, resource := range resources {
+		if resource.MemoID != nil {
+			memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: resource.MemoID, ExcludeContent: true})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to find memo by ID: %v", resource.MemoID)
+			}
+			// Skip the resources of memos in the trash, unless the user can see the memo there.
+			if memo != nil && memo.RowStatus == store.Trashed && !canSeeTrashedMemo(user, memo) {
+				continue
+			}
+		}
 		response.Resources = append(response.Resources, s.convertResourceFromStore(ctx, resource))
 	}
 	return response, nil
@@ -161,7 +181,17 @@ func (s *APIV1Service) GetResourceBinary(ctx context.Context, request *v1pb.GetR
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to find memo by ID: %v", resource.MemoID)
 		}
-		if memo != nil && memo.Visibility != store.Public {
+		if memo != nil && memo.RowStatus == store.Trashed {
+			user, err := s.GetCurrentUser(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+			}
+			if !canSeeTrashedMemo(user, memo) {
+				return nil, status.Errorf(codes.NotFound, "resource not found")
+			}
+		}
+		// A share link of the memo grants access to its resources.
+		if memo != nil && memo.Visibility != store.Public && !hasMemoShare(ctx, memo.ID) {
 			user, err := s.GetCurrentUser(ctx)
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
@@ -169,7 +199,11 @@ func (s *APIV1Service) GetResourceBinary(ctx context.Context, request *v1pb.GetR
 			if user == nil {
 				return nil, status.Errorf(codes.Unauthenticated, "unauthorized access")
 			}
-			if memo.Visibility == store.Private && user.ID != resource.CreatorID {
+			canRead, err := s.canReadMemo(ctx, user, memo)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to check memo access: %v", err)
+			}
+			if !canRead {
 				return nil, status.Errorf(codes.Unauthenticated, "unauthorized access")
 			}
 		}
@@ -217,11 +251,19 @@ func (s *APIV1Service) UpdateResource(ctx context.Context, request *v1pb.UpdateR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get resource: %v", err)
 	}
+	if resource == nil {
+		return nil, status.Errorf(codes.NotFound, "resource not found")
+	}
+	if request.Resource.Etag != "" && request.Resource.Etag != getResourceEtag(resource) {
+		return nil, newEtagMismatchError(s.convertResourceFromStore(ctx, resource))
+	}
 
 	currentTs := time.Now().Unix()
 	update := &store.UpdateResource{
 		ID:        resource.ID,
 		UpdatedTs: &currentTs,
+		// The update is based on the version read above, so a concurrent update in between is rejected.
+		Version: &resource.Version,
 	}
 	for _, field := range request.UpdateMask.Paths {
 		if field == "filename" {
@@ -230,7 +272,15 @@ func (s *APIV1Service) UpdateResource(ctx context.Context, request *v1pb.UpdateR
 	}
 
 	if err := s.Store.UpdateResource(ctx, update); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update resource: %v", err)
+		if !errors.Is(err, store.ErrResourceVersionMismatch) {
+			return nil, status.Errorf(codes.Internal, "failed to update resource: %v", err)
+		}
+		// The resource has been updated since it was read, the current version is returned to the client.
+		current, err := s.Store.GetResource(ctx, &store.FindResource{ID: &resource.ID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get resource: %v", err)
+		}
+		return nil, newEtagMismatchError(s.convertResourceFromStore(ctx, current))
 	}
 	return s.GetResource(ctx, &v1pb.GetResourceRequest{
 		Name: request.Resource.Name,
@@ -266,24 +316,31 @@ func (s *APIV1Service) DeleteResource(ctx context.Context, request *v1pb.DeleteR
 }
 
 func (s *APIV1Service) convertResourceFromStore(ctx context.Context, resource *store.Resource) *v1pb.Resource {
+	var memo *store.Memo
+	if resource.MemoID != nil {
+		memo, _ = s.Store.GetMemo(ctx, &store.FindMemo{
+			ID: resource.MemoID,
+		})
+	}
+	return convertResourceFromStoreWithMemo(resource, memo)
+}
+
+// convertResourceFromStoreWithMemo converts the resource with its loaded memo, which may be nil.
+func convertResourceFromStoreWithMemo(resource *store.Resource, memo *store.Memo) *v1pb.Resource {
 	resourceMessage := &v1pb.Resource{
 		Name:       fmt.Sprintf("%s%s", ResourceNamePrefix, resource.UID),
 		CreateTime: timestamppb.New(time.Unix(resource.CreatedTs, 0)),
 		Filename:   resource.Filename,
 		Type:       resource.Type,
 		Size:       resource.Size,
+		Etag:       getResourceEtag(resource),
 	}
 	if resource.StorageType == storepb.ResourceStorageType_EXTERNAL || resource.StorageType == storepb.ResourceStorageType_S3 {
 		resourceMessage.ExternalLink = resource.Reference
 	}
-	if resource.MemoID != nil {
-		memo, _ := s.Store.GetMemo(ctx, &store.FindMemo{
-			ID: resource.MemoID,
-		})
-		if memo != nil {
-			memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
-			resourceMessage.Memo = &memoName
-		}
+	if memo != nil {
+		memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+		resourceMessage.Memo = &memoName
 	}
 
 	return resourceMessage
@@ -474,3 +531,8 @@ func replaceFilenameWithPathTemplate(path, filename string) string {
 	})
 	return path
 }
+
+// getResourceEtag returns the etag of the resource version, which is bumped on every update.
+func getResourceEtag(resource *store.Resource) string {
+	return getEtag(strconv.FormatInt(int64(resource.ID), 10), strconv.FormatInt(int64(resource.Version), 10))
+}
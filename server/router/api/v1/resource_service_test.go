@@ -0,0 +1,109 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestUpdateResourceEtag(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	userCtx := withTestingUser(ctx, user)
+	resource, err := s.CreateResource(userCtx, &v1pb.CreateResourceRequest{Resource: &v1pb.Resource{
+		Filename: "first.txt",
+		Type:     "text/plain",
+		Content:  []byte("hello"),
+	}})
+	require.NoError(t, err)
+	require.NotEmpty(t, resource.Etag)
+
+	renamed, err := s.UpdateResource(userCtx, &v1pb.UpdateResourceRequest{
+		Resource:   &v1pb.Resource{Name: resource.Name, Filename: "second.txt", Etag: resource.Etag},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"filename"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "second.txt", renamed.Filename)
+	require.NotEqual(t, resource.Etag, renamed.Etag)
+
+	// An update based on a stale etag is aborted with the current version.
+	_, err = s.UpdateResource(userCtx, &v1pb.UpdateResourceRequest{
+		Resource:   &v1pb.Resource{Name: resource.Name, Filename: "stale.txt", Etag: resource.Etag},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"filename"}},
+	})
+	requireEtagMismatch(t, err, renamed)
+
+	// An update without an etag is applied unconditionally.
+	updated, err := s.UpdateResource(userCtx, &v1pb.UpdateResourceRequest{
+		Resource:   &v1pb.Resource{Name: resource.Name, Filename: "third.txt"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"filename"}},
+	})
+	require.NoError(t, err)
+
+	// The etag changes with every update, even in the same second without changing the filename.
+	unchanged, err := s.UpdateResource(userCtx, &v1pb.UpdateResourceRequest{
+		Resource:   &v1pb.Resource{Name: resource.Name, Filename: "third.txt", Etag: updated.Etag},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"filename"}},
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, updated.Etag, unchanged.Etag)
+	_, err = s.UpdateResource(userCtx, &v1pb.UpdateResourceRequest{
+		Resource:   &v1pb.Resource{Name: resource.Name, Filename: "fourth.txt", Etag: updated.Etag},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"filename"}},
+	})
+	requireEtagMismatch(t, err, unchanged)
+}
+
+func TestTrashedMemoResources(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin := createTestingUser(ctx, t, s, "admin", store.RoleHost)
+	creator := createTestingUser(ctx, t, s, "creator", store.RoleUser)
+	other := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	creatorCtx := withTestingUser(ctx, creator)
+	resource, err := s.CreateResource(creatorCtx, &v1pb.CreateResourceRequest{Resource: &v1pb.Resource{
+		Filename: "hello.txt",
+		Type:     "text/plain",
+		Content:  []byte("hello"),
+	}})
+	require.NoError(t, err)
+	memo, err := s.CreateMemo(creatorCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{
+		Content:    "with resource",
+		Visibility: v1pb.Visibility_PUBLIC,
+		Resources:  []*v1pb.Resource{{Name: resource.Name}},
+	}})
+	require.NoError(t, err)
+	_, err = s.DeleteMemo(creatorCtx, &v1pb.DeleteMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+
+	// The resources of the memo in the trash are visible to those who see the memo there, the creator and the admins.
+	for _, testCase := range []struct {
+		ctx     context.Context
+		visible bool
+	}{
+		{ctx: creatorCtx, visible: true},
+		{ctx: withTestingUser(ctx, admin), visible: true},
+		{ctx: withTestingUser(ctx, other), visible: false},
+		{ctx: ctx, visible: false},
+	} {
+		_, err := s.GetMemo(testCase.ctx, &v1pb.GetMemoRequest{Name: memo.Name})
+		require.Equal(t, testCase.visible, err == nil)
+		_, err = s.GetResourceBinary(testCase.ctx, &v1pb.GetResourceBinaryRequest{Name: resource.Name})
+		if testCase.visible {
+			require.NoError(t, err)
+		} else {
+			require.Equal(t, codes.NotFound, status.Code(err))
+		}
+		response, err := s.ListResources(testCase.ctx, &v1pb.ListResourcesRequest{Parent: getUserPrincipal(creator)})
+		require.NoError(t, err)
+		require.Equal(t, testCase.visible, len(response.Resources) == 1)
+	}
+}
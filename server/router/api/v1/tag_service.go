@@ -0,0 +1,277 @@
+package v1
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListTags(ctx context.Context, request *v1pb.ListTagsRequest) (*v1pb.ListTagsResponse, error) {
+	userID, err := s.checkTagParent(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	tagMap, err := s.buildTagMap(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	tags := []*v1pb.Tag{}
+	for name, tag := range tagMap {
+		if !strings.Contains(name, "/") {
+			tags = append(tags, tag)
+		}
+	}
+	sortTags(tags)
+	return &v1pb.ListTagsResponse{
+		Tags: tags,
+	}, nil
+}
+
+func (s *APIV1Service) UpdateTag(ctx context.Context, request *v1pb.UpdateTagRequest) (*v1pb.Tag, error) {
+	userID, err := s.checkTagParent(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if request.Tag == nil || strings.TrimSpace(request.Tag.Tag) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "tag is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+
+	tagsUserSetting, err := s.getTagsUserSetting(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var metadata *storepb.TagsUserSetting_Tag
+	for _, tag := range tagsUserSetting.Tags {
+		if tag.Tag == request.Tag.Tag {
+			metadata = tag
+			break
+		}
+	}
+	if metadata == nil {
+		metadata = &storepb.TagsUserSetting_Tag{Tag: request.Tag.Tag}
+		tagsUserSetting.Tags = append(tagsUserSetting.Tags, metadata)
+	}
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "color":
+			metadata.Color = request.Tag.Color
+		case "description":
+			metadata.Description = request.Tag.Description
+		case "pinned":
+			metadata.Pinned = request.Tag.Pinned
+		case "emoji":
+			metadata.Emoji = request.Tag.Emoji
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update mask path: %s", field)
+		}
+	}
+	// Drop the metadata that is left empty, so the setting only keeps customized tags.
+	tagsUserSetting.Tags = slices.DeleteFunc(tagsUserSetting.Tags, func(tag *storepb.TagsUserSetting_Tag) bool {
+		return tag.Color == "" && tag.Description == "" && !tag.Pinned && tag.Emoji == ""
+	})
+	if err := s.upsertTagsUserSetting(ctx, userID, tagsUserSetting); err != nil {
+		return nil, err
+	}
+
+	tagMap, err := s.buildTagMap(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tag, ok := tagMap[request.Tag.Tag]; ok {
+		return tag, nil
+	}
+	return convertTagFromStore(metadata), nil
+}
+
+func (s *APIV1Service) MergeTags(ctx context.Context, request *v1pb.MergeTagsRequest) (*v1pb.Tag, error) {
+	userID, err := s.checkTagParent(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if strings.TrimSpace(request.TargetTag) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "target tag is required")
+	}
+	if len(request.SourceTags) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "source tags are required")
+	}
+	for _, sourceTag := range request.SourceTags {
+		if strings.TrimSpace(sourceTag) == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "source tag is required")
+		}
+		if sourceTag == request.TargetTag {
+			return nil, status.Errorf(codes.InvalidArgument, "source tag %s is the target tag", sourceTag)
+		}
+	}
+
+	if err := s.withTx(ctx, func(tx *APIV1Service) error {
+		for _, sourceTag := range request.SourceTags {
+			memos, err := tx.Store.ListMemos(ctx, &store.FindMemo{
+				CreatorID:       &userID,
+				PayloadFind:     &store.FindMemoPayload{TagSearch: []string{sourceTag}},
+				ExcludeComments: true,
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to list memos: %v", err)
+			}
+			if err := tx.renameTagInMemos(ctx, excludeTrashedMemos(memos), sourceTag, request.TargetTag, currentUser.ID); err != nil {
+				return err
+			}
+		}
+
+		tagsUserSetting, err := tx.getTagsUserSetting(ctx, userID)
+		if err != nil {
+			return err
+		}
+		tagsUserSetting.Tags = slices.DeleteFunc(tagsUserSetting.Tags, func(tag *storepb.TagsUserSetting_Tag) bool {
+			return slices.Contains(request.SourceTags, tag.Tag)
+		})
+		return tx.upsertTagsUserSetting(ctx, userID, tagsUserSetting)
+	}); err != nil {
+		return nil, err
+	}
+
+	tagMap, err := s.buildTagMap(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tag, ok := tagMap[request.TargetTag]; ok {
+		return tag, nil
+	}
+	return &v1pb.Tag{Tag: request.TargetTag}, nil
+}
+
+// checkTagParent returns the id of the parent user if the current user can manage its tags.
+func (s *APIV1Service) checkTagParent(ctx context.Context, parent string) (int32, error) {
+	userID, err := ExtractUserIDFromName(parent)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return 0, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID && !isSuperUser(currentUser) {
+		return 0, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return userID, nil
+}
+
+func (s *APIV1Service) getTagsUserSetting(ctx context.Context, userID int32) (*storepb.TagsUserSetting, error) {
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSettingKey_TAGS,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+	}
+	if userSetting == nil || userSetting.GetTags() == nil {
+		return &storepb.TagsUserSetting{}, nil
+	}
+	return userSetting.GetTags(), nil
+}
+
+func (s *APIV1Service) upsertTagsUserSetting(ctx context.Context, userID int32, tagsUserSetting *storepb.TagsUserSetting) error {
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSettingKey_TAGS,
+		Value: &storepb.UserSetting_Tags{
+			Tags: tagsUserSetting,
+		},
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	return nil
+}
+
+// buildTagMap returns every tag of the user and its ancestors by the full path,
+// with the sub tags nested in their parents.
+func (s *APIV1Service) buildTagMap(ctx context.Context, userID int32) (map[string]*v1pb.Tag, error) {
+	normalStatus := store.Normal
+	memoTagCounts, err := s.Store.CountMemoTags(ctx, &store.FindMemoTagCount{
+		CreatorID:       &userID,
+		RowStatus:       &normalStatus,
+		ExcludeComments: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count memo tags: %v", err)
+	}
+	tagsUserSetting, err := s.getTagsUserSetting(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	metadataMap := map[string]*storepb.TagsUserSetting_Tag{}
+	for _, metadata := range tagsUserSetting.Tags {
+		metadataMap[metadata.Tag] = metadata
+	}
+
+	tagMap := map[string]*v1pb.Tag{}
+	// getOrCreate returns the tag of the path, creating it and its ancestors if needed.
+	var getOrCreate func(path string) *v1pb.Tag
+	getOrCreate = func(path string) *v1pb.Tag {
+		if tag, ok := tagMap[path]; ok {
+			return tag
+		}
+		tag := &v1pb.Tag{Tag: path}
+		if metadata, ok := metadataMap[path]; ok {
+			tag = convertTagFromStore(metadata)
+		}
+		tagMap[path] = tag
+		if index := strings.LastIndex(path, "/"); index > 0 {
+			parent := getOrCreate(path[:index])
+			parent.Children = append(parent.Children, tag)
+		}
+		return tag
+	}
+	for _, memoTagCount := range memoTagCounts {
+		tag := getOrCreate(memoTagCount.Tag)
+		tag.MemoCount = memoTagCount.Count
+		tag.LastUsedTime = timestamppb.New(time.Unix(memoTagCount.LastUsedTs, 0))
+	}
+	for _, tag := range tagMap {
+		sortTags(tag.Children)
+	}
+	return tagMap, nil
+}
+
+// sortTags sorts the pinned tags first, then by the tag path.
+func sortTags(tags []*v1pb.Tag) {
+	slices.SortFunc(tags, func(a, b *v1pb.Tag) int {
+		if a.Pinned != b.Pinned {
+			if a.Pinned {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Tag, b.Tag)
+	})
+}
+
+func convertTagFromStore(metadata *storepb.TagsUserSetting_Tag) *v1pb.Tag {
+	return &v1pb.Tag{
+		Tag:         metadata.Tag,
+		Color:       metadata.Color,
+		Description: metadata.Description,
+		Pinned:      metadata.Pinned,
+		Emoji:       metadata.Emoji,
+	}
+}
@@ -0,0 +1,189 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) BlockUser(ctx context.Context, request *v1pb.BlockUserRequest) (*v1pb.UserBlock, error) {
+	user, blockedUser, blockType, err := s.getUserBlockOfRequest(ctx, request.Block)
+	if err != nil {
+		return nil, err
+	}
+
+	var userBlock *store.UserBlock
+	if err := s.Store.WithTx(ctx, func(tx *store.Store) error {
+		var err error
+		userBlock, err = tx.UpsertUserBlock(ctx, &store.UserBlock{
+			UserID:        user.ID,
+			BlockedUserID: blockedUser.ID,
+			Type:          blockType,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to upsert user block")
+		}
+		if blockType != store.UserBlockTypeBlock {
+			return nil
+		}
+		// Blocking removes the follow relationship in both directions.
+		for _, pair := range [][2]int32{{user.ID, blockedUser.ID}, {blockedUser.ID, user.ID}} {
+			if err := tx.UnFollowUser(ctx, &store.UserFollowing{
+				UserID:          pair[0],
+				FollowingUserID: pair[1],
+			}); err != nil {
+				return errors.Wrap(err, "failed to unfollow user")
+			}
+			followRequest, err := tx.GetFollowRequest(ctx, &store.FindFollowRequest{
+				RequesterID: &pair[0],
+				UserID:      &pair[1],
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to get follow request")
+			}
+			if followRequest != nil {
+				if err := tx.DeleteFollowRequest(ctx, &store.DeleteFollowRequest{ID: followRequest.ID}); err != nil {
+					return errors.Wrap(err, "failed to delete follow request")
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to block user: %v", err)
+	}
+	return convertUserBlockFromStore(userBlock), nil
+}
+
+func (s *APIV1Service) UnblockUser(ctx context.Context, request *v1pb.UnblockUserRequest) (*emptypb.Empty, error) {
+	user, blockedUser, blockType, err := s.getUserBlockOfRequest(ctx, request.Block)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Store.DeleteUserBlock(ctx, &store.DeleteUserBlock{
+		UserID:        user.ID,
+		BlockedUserID: blockedUser.ID,
+		Type:          blockType,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete user block: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) ListUserBlocks(ctx context.Context, request *v1pb.ListUserBlocksRequest) (*v1pb.ListUserBlocksResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	userBlockFind := &store.FindUserBlock{
+		UserID: &user.ID,
+	}
+	if request.Type != v1pb.UserBlock_TYPE_UNSPECIFIED {
+		blockType, err := convertUserBlockTypeToStore(request.Type)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid type: %v", err)
+		}
+		userBlockFind.Type = &blockType
+	}
+	userBlocks, err := s.Store.ListUserBlocks(ctx, userBlockFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list user blocks: %v", err)
+	}
+	response := &v1pb.ListUserBlocksResponse{
+		Blocks: []*v1pb.UserBlock{},
+	}
+	for _, userBlock := range userBlocks {
+		response.Blocks = append(response.Blocks, convertUserBlockFromStore(userBlock))
+	}
+	return response, nil
+}
+
+// getUserBlockOfRequest returns the current user, the blocked user and the type of the block.
+func (s *APIV1Service) getUserBlockOfRequest(ctx context.Context, block *v1pb.UserBlock) (*store.User, *store.User, store.UserBlockType, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, nil, "", status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, nil, "", status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if block == nil {
+		return nil, nil, "", status.Errorf(codes.InvalidArgument, "block is required")
+	}
+	blockType, err := convertUserBlockTypeToStore(block.Type)
+	if err != nil {
+		return nil, nil, "", status.Errorf(codes.InvalidArgument, "invalid type: %v", err)
+	}
+	blockedUserID, err := ExtractUserIDFromName(block.BlockedUser)
+	if err != nil {
+		return nil, nil, "", status.Errorf(codes.InvalidArgument, "invalid blocked user: %v", err)
+	}
+	if blockedUserID == user.ID {
+		return nil, nil, "", status.Errorf(codes.InvalidArgument, "cannot block yourself")
+	}
+	blockedUser, err := s.Store.GetUser(ctx, &store.FindUser{ID: &blockedUserID})
+	if err != nil {
+		return nil, nil, "", status.Errorf(codes.Internal, "failed to get blocked user: %v", err)
+	}
+	if blockedUser == nil {
+		return nil, nil, "", status.Errorf(codes.NotFound, "blocked user not found")
+	}
+	return user, blockedUser, blockType, nil
+}
+
+// isBlockedByUser returns whether the user has blocked the other user.
+func (s *APIV1Service) isBlockedByUser(ctx context.Context, userID, blockedUserID int32) (bool, error) {
+	blockType := store.UserBlockTypeBlock
+	userBlock, err := s.Store.GetUserBlock(ctx, &store.FindUserBlock{
+		UserID:        &userID,
+		BlockedUserID: &blockedUserID,
+		Type:          &blockType,
+	})
+	if err != nil {
+		return false, err
+	}
+	return userBlock != nil, nil
+}
+
+func convertUserBlockFromStore(userBlock *store.UserBlock) *v1pb.UserBlock {
+	return &v1pb.UserBlock{
+		BlockedUser: fmt.Sprintf("%s%d", UserNamePrefix, userBlock.BlockedUserID),
+		Type:        convertUserBlockTypeFromStore(userBlock.Type),
+		CreateTime:  timestamppb.New(time.Unix(userBlock.CreatedTs, 0)),
+	}
+}
+
+func convertUserBlockTypeFromStore(blockType store.UserBlockType) v1pb.UserBlock_Type {
+	switch blockType {
+	case store.UserBlockTypeBlock:
+		return v1pb.UserBlock_BLOCK
+	case store.UserBlockTypeMute:
+		return v1pb.UserBlock_MUTE
+	default:
+		return v1pb.UserBlock_TYPE_UNSPECIFIED
+	}
+}
+
+func convertUserBlockTypeToStore(blockType v1pb.UserBlock_Type) (store.UserBlockType, error) {
+	switch blockType {
+	case v1pb.UserBlock_BLOCK:
+		return store.UserBlockTypeBlock, nil
+	case v1pb.UserBlock_MUTE:
+		return store.UserBlockTypeMute, nil
+	default:
+		return "", errors.Errorf("unsupported user block type %s", blockType)
+	}
+}
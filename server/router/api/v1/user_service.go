@@ -22,6 +22,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/filter"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
@@ -45,11 +46,132 @@ func (s *APIV1Service) ListUsers(ctx context.Context, _ *v1pb.ListUsersRequest)
 		Users: []*v1pb.User{},
 	}
 	for _, user := range users {
-		response.Users = append(response.Users, convertUserFromStore(user))
+		userMessage, err := s.convertUserFromStoreForViewer(ctx, currentUser, user)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert user: %v", err)
+		}
+		response.Users = append(response.Users, userMessage)
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) SearchUsers(ctx context.Context, request *v1pb.SearchUsersRequest) (*v1pb.SearchUsersResponse, error) {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+
+	normalStatus := store.Normal
+	userFind := &store.FindUser{
+		RowStatus: &normalStatus,
+	}
+	if request.Filter != "" {
+		if _, err := filter.Parse(request.Filter, filter.UserFilterCELAttributes...); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		userFind.Filter = &request.Filter
+	}
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limitPlusOne := limit + 1
+	userFind.Limit = &limitPlusOne
+	userFind.Offset = &offset
+	users, err := s.Store.ListUsers(ctx, userFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search users: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(users) == limitPlusOne {
+		users = users[:limit]
+		nextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+	response := &v1pb.SearchUsersResponse{
+		Users:         []*v1pb.User{},
+		NextPageToken: nextPageToken,
+	}
+	for _, user := range users {
+		userMessage, err := s.convertUserFromStoreForViewer(ctx, currentUser, user)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert user: %v", err)
+		}
+		response.Users = append(response.Users, userMessage)
 	}
 	return response, nil
 }
 
+// convertUserFromStoreForViewer converts the user as seen by the viewer, who is nil for the anonymous requests.
+// The email and the private profile fields are only visible to the user and the admins.
+func (s *APIV1Service) convertUserFromStoreForViewer(ctx context.Context, viewer *store.User, user *store.User) (*v1pb.User, error) {
+	userMessage := convertUserFromStore(user)
+	if viewer != nil && (viewer.ID == user.ID || isSuperUser(viewer)) {
+		return userMessage, nil
+	}
+	userMessage.Email = ""
+	if err := s.clearPrivateProfileFields(ctx, user.ID, userMessage); err != nil {
+		return nil, errors.Wrap(err, "failed to clear private profile fields")
+	}
+	return userMessage, nil
+}
+
+// getVisibleUser converts the user as seen by the current user.
+func (s *APIV1Service) getVisibleUser(ctx context.Context, user *store.User) (*v1pb.User, error) {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	userMessage, err := s.convertUserFromStoreForViewer(ctx, currentUser, user)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert user: %v", err)
+	}
+	return userMessage, nil
+}
+
+// clearPrivateProfileFields clears the profile fields the user hides from the other users.
+func (s *APIV1Service) clearPrivateProfileFields(ctx context.Context, userID int32, user *v1pb.User) error {
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSettingKey_PROFILE_PRIVACY,
+	})
+	if err != nil {
+		return err
+	}
+	for _, field := range userSetting.GetProfilePrivacy().GetPrivateFields() {
+		switch field {
+		case "description":
+			user.Description = ""
+		case "gender":
+			user.Gender = ""
+		case "birth_date":
+			user.BirthDate = nil
+		case "location":
+			user.Location = ""
+		case "industry":
+			user.Industry = ""
+		case "occupation":
+			user.Occupation = ""
+		case "university":
+			user.University = ""
+		}
+	}
+	return nil
+}
+
 func (s *APIV1Service) GetUser(ctx context.Context, request *v1pb.GetUserRequest) (*v1pb.User, error) {
 	userID, err := ExtractUserIDFromName(request.Name)
 	if err != nil {
@@ -64,8 +186,7 @@ func (s *APIV1Service) GetUser(ctx context.Context, request *v1pb.GetUserRequest
 	if user == nil {
 		return nil, status.Errorf(codes.NotFound, "user not found")
 	}
-
-	return convertUserFromStore(user), nil
+	return s.getVisibleUser(ctx, user)
 }
 
 func (s *APIV1Service) GetUserByUsername(ctx context.Context, request *v1pb.GetUserByUsernameRequest) (*v1pb.User, error) {
@@ -78,8 +199,7 @@ func (s *APIV1Service) GetUserByUsername(ctx context.Context, request *v1pb.GetU
 	if user == nil {
 		return nil, status.Errorf(codes.NotFound, "user not found")
 	}
-
-	return convertUserFromStore(user), nil
+	return s.getVisibleUser(ctx, user)
 }
 
 func (s *APIV1Service) GetUserAvatarBinary(ctx context.Context, request *v1pb.GetUserAvatarBinaryRequest) (*httpbody.HttpBody, error) {
@@ -309,51 +429,185 @@ func (s *APIV1Service) IsFollowingUser(ctx context.Context, request *v1pb.IsFoll
 }
 
 func (s *APIV1Service) FollowUser(ctx context.Context, request *v1pb.FollowUserRequest) (*v1pb.UserFollowing, error) {
-	currentUser, err := s.GetCurrentUser(ctx)
+	currentUser, followingUser, err := s.getFollowUsers(ctx, request.Follow)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+		return nil, err
+	}
+	if followingUser.ID == currentUser.ID {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot follow yourself")
+	}
+	blocked, err := s.isBlockedByUser(ctx, followingUser.ID, currentUser.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check user block: %v", err)
+	}
+	if blocked {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	userFollowing, err := s.Store.GetUserFollowing(ctx, &store.FindUserFollowing{
+		UserID:          &currentUser.ID,
+		FollowingUserID: &followingUser.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user following: %v", err)
+	}
+	if userFollowing != nil {
+		return s.getUserFollowing(ctx, currentUser, followingUser)
 	}
 
-	// currentUserID, err := ExtractUserIDFromName(request.Follow.UserName)
-	currentUserID := currentUser.ID
-	targetUsername := request.Follow.FollowingUserName
-	user, err := s.Store.GetUser(ctx, &store.FindUser{Username: &targetUsername})
+	// The user approving their followers is only requested to be followed.
+	approvalRequired, err := s.isFollowApprovalRequired(ctx, followingUser.ID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get target user: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
 	}
-	if user == nil {
-		return nil, status.Errorf(codes.NotFound, "target user not found")
+	if approvalRequired {
+		if err := s.requestToFollow(ctx, currentUser, followingUser); err != nil {
+			return nil, err
+		}
+		return &v1pb.UserFollowing{
+			UserName:          currentUser.Username,
+			FollowingUserName: followingUser.Username,
+			Pending:           true,
+		}, nil
 	}
 
-	result, err := s.Store.IsFollowingUser(ctx, &store.UserFollowing{
-		UserID:          currentUserID,
-		FollowingUserID: user.ID,
-	})
+	if err := s.Store.FollowUser(ctx, &store.UserFollowing{
+		UserID:          currentUser.ID,
+		FollowingUserID: followingUser.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to follow user: %v", err)
+	}
+	return s.getUserFollowing(ctx, currentUser, followingUser)
+}
+
+func (s *APIV1Service) UnfollowUser(ctx context.Context, request *v1pb.UnfollowUserRequest) (*emptypb.Empty, error) {
+	currentUser, followingUser, err := s.getFollowUsers(ctx, request.Follow)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check following status: %v", err)
+		return nil, err
 	}
 
-	if result {
-		err := s.Store.UnFollowUser(ctx, &store.UserFollowing{
-			UserID:          currentUserID,
-			FollowingUserID: user.ID,
-		})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to unfollow user: %v", err)
+	if err := s.Store.UnFollowUser(ctx, &store.UserFollowing{
+		UserID:          currentUser.ID,
+		FollowingUserID: followingUser.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unfollow user: %v", err)
+	}
+	// The pending follow request is withdrawn as well.
+	followRequest, err := s.Store.GetFollowRequest(ctx, &store.FindFollowRequest{
+		RequesterID: &currentUser.ID,
+		UserID:      &followingUser.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get follow request: %v", err)
+	}
+	if followRequest != nil {
+		if err := s.Store.DeleteFollowRequest(ctx, &store.DeleteFollowRequest{ID: followRequest.ID}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to delete follow request: %v", err)
 		}
-	} else {
-		err := s.Store.FollowUser(ctx, &store.UserFollowing{
-			UserID:          currentUserID,
-			FollowingUserID: user.ID,
-		})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to follow user: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) SetFollowTag(ctx context.Context, request *v1pb.SetFollowTagRequest) (*v1pb.UserFollowing, error) {
+	currentUser, followingUser, err := s.getFollowUsers(ctx, request.Follow)
+	if err != nil {
+		return nil, err
+	}
+	tag := strings.TrimSpace(request.Follow.Tag)
+	if tag == "" {
+		tag = store.DefaultFollowTag
+	}
+	if len(tag) > 256 {
+		return nil, status.Errorf(codes.InvalidArgument, "tag is too long")
+	}
+
+	userFollowing, err := s.Store.GetUserFollowing(ctx, &store.FindUserFollowing{
+		UserID:          &currentUser.ID,
+		FollowingUserID: &followingUser.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user following: %v", err)
+	}
+	if userFollowing == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "user is not followed")
+	}
+	if err := s.Store.UpdateUserFollowing(ctx, &store.UpdateUserFollowing{
+		UserID:          currentUser.ID,
+		FollowingUserID: followingUser.ID,
+		Tag:             &tag,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update user following: %v", err)
+	}
+	return s.getUserFollowing(ctx, currentUser, followingUser)
+}
+
+func (s *APIV1Service) ListFollowCircles(ctx context.Context, _ *v1pb.ListFollowCirclesRequest) (*v1pb.ListFollowCirclesResponse, error) {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	userFollowings, err := s.Store.ListUserFollowings(ctx, &store.FindUserFollowing{
+		UserID: &currentUser.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list user followings: %v", err)
+	}
+	// The followings are ordered by their tags, so the users of a circle are adjacent.
+	response := &v1pb.ListFollowCirclesResponse{}
+	var circle *v1pb.FollowCircle
+	for _, userFollowing := range userFollowings {
+		if circle == nil || circle.Name != userFollowing.Tag {
+			circle = &v1pb.FollowCircle{
+				Name: userFollowing.Tag,
+			}
+			response.Circles = append(response.Circles, circle)
 		}
+		circle.Users = append(circle.Users, fmt.Sprintf("%s%d", UserNamePrefix, userFollowing.FollowingUserID))
 	}
+	return response, nil
+}
 
+// getFollowUsers returns the current user and the user to follow by the name in the request.
+func (s *APIV1Service) getFollowUsers(ctx context.Context, follow *v1pb.UserFollowing) (*store.User, *store.User, error) {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if follow == nil || follow.FollowingUserName == "" {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "following user name is required")
+	}
+	followingUser, err := s.Store.GetUser(ctx, &store.FindUser{Username: &follow.FollowingUserName})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get target user: %v", err)
+	}
+	if followingUser == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "target user not found")
+	}
+	return currentUser, followingUser, nil
+}
+
+func (s *APIV1Service) getUserFollowing(ctx context.Context, currentUser, followingUser *store.User) (*v1pb.UserFollowing, error) {
+	userFollowing, err := s.Store.GetUserFollowing(ctx, &store.FindUserFollowing{
+		UserID:          &currentUser.ID,
+		FollowingUserID: &followingUser.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user following: %v", err)
+	}
+	if userFollowing == nil {
+		return nil, status.Errorf(codes.NotFound, "user following not found")
+	}
 	return &v1pb.UserFollowing{
-		UserName:          request.Follow.UserName,
-		FollowingUserName: request.Follow.FollowingUserName,
+		UserName:          currentUser.Username,
+		FollowingUserName: followingUser.Username,
+		CreateTime:        timestamppb.New(time.Unix(userFollowing.CreatedTs, 0)),
+		Tag:               userFollowing.Tag,
 	}, nil
 }
 
@@ -381,9 +635,16 @@ func (s *APIV1Service) GetFollowingList(ctx context.Context, request *v1pb.GetFo
 	response := &v1pb.FollowingListResponse{
 		Users: []*v1pb.User{},
 	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
 	for _, user := range followingList {
 		// log.Printf("Original Following user: %s", user)
-		convertedUser := convertUserFromStore(user)
+		convertedUser, err := s.convertUserFromStoreForViewer(ctx, currentUser, user)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert user: %v", err)
+		}
 		// log.Printf("Converted Following user: %s", convertedUser)
 		response2.Users = append(response2.Users, convertedUser)
 		response.Users = append(response.Users, convertedUser)
@@ -422,8 +683,15 @@ func (s *APIV1Service) GetFollowerList(ctx context.Context, request *v1pb.GetFol
 	response := &v1pb.FollowerListResponse{
 		Users: []*v1pb.User{},
 	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
 	for _, user := range followerList {
-		convertedUser := convertUserFromStore(user)
+		convertedUser, err := s.convertUserFromStoreForViewer(ctx, currentUser, user)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert user: %v", err)
+		}
 		response.Users = append(response.Users, convertedUser)
 	}
 	return response, nil
@@ -457,6 +725,10 @@ func (s *APIV1Service) GetUserSetting(ctx context.Context, _ *v1pb.GetUserSettin
 			userSettingMessage.Appearance = setting.GetAppearance()
 		} else if setting.Key == storepb.UserSettingKey_MEMO_VISIBILITY {
 			userSettingMessage.MemoVisibility = setting.GetMemoVisibility()
+		} else if setting.Key == storepb.UserSettingKey_APPROVE_FOLLOWERS {
+			userSettingMessage.ApproveFollowers = setting.GetApproveFollowers()
+		} else if setting.Key == storepb.UserSettingKey_PROFILE_PRIVACY {
+			userSettingMessage.PrivateProfileFields = setting.GetProfilePrivacy().GetPrivateFields()
 		}
 	}
 	return userSettingMessage, nil
@@ -503,6 +775,33 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 			}); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
 			}
+		} else if field == "approve_followers" {
+			if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+				UserId: user.ID,
+				Key:    storepb.UserSettingKey_APPROVE_FOLLOWERS,
+				Value: &storepb.UserSetting_ApproveFollowers{
+					ApproveFollowers: request.Setting.ApproveFollowers,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+			}
+		} else if field == "private_profile_fields" {
+			for _, privateField := range request.Setting.PrivateProfileFields {
+				if !slices.Contains(store.PrivateProfileFields, privateField) {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid private profile field: %s", privateField)
+				}
+			}
+			if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+				UserId: user.ID,
+				Key:    storepb.UserSettingKey_PROFILE_PRIVACY,
+				Value: &storepb.UserSetting_ProfilePrivacy{
+					ProfilePrivacy: &storepb.ProfilePrivacyUserSetting{
+						PrivateFields: request.Setting.PrivateProfileFields,
+					},
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+			}
 		} else {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", field)
 		}
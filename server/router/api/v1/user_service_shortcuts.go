@@ -45,11 +45,7 @@ func (s *APIV1Service) ListShortcuts(ctx context.Context, request *v1pb.ListShor
 	shortcutsUserSetting := userSetting.GetShortcuts()
 	shortcuts := []*v1pb.Shortcut{}
 	for _, shortcut := range shortcutsUserSetting.GetShortcuts() {
-		shortcuts = append(shortcuts, &v1pb.Shortcut{
-			Id:     shortcut.GetId(),
-			Title:  shortcut.GetTitle(),
-			Filter: shortcut.GetFilter(),
-		})
+		shortcuts = append(shortcuts, convertShortcutFromStore(shortcut))
 	}
 
 	return &v1pb.ListShortcutsResponse{
@@ -83,11 +79,7 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 	}
 	if request.ValidateOnly {
-		return &v1pb.Shortcut{
-			Id:     newShortcut.GetId(),
-			Title:  newShortcut.GetTitle(),
-			Filter: newShortcut.GetFilter(),
-		}, nil
+		return convertShortcutFromStore(newShortcut), nil
 	}
 
 	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
@@ -122,11 +114,7 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 		return nil, err
 	}
 
-	return &v1pb.Shortcut{
-		Id:     request.Shortcut.GetId(),
-		Title:  request.Shortcut.GetTitle(),
-		Filter: request.Shortcut.GetFilter(),
-	}, nil
+	return convertShortcutFromStore(newShortcut), nil
 }
 
 func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateShortcutRequest) (*v1pb.Shortcut, error) {
@@ -160,8 +148,12 @@ func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateS
 	shortcutsUserSetting := userSetting.GetShortcuts()
 	shortcuts := shortcutsUserSetting.GetShortcuts()
 	newShortcuts := make([]*storepb.ShortcutsUserSetting_Shortcut, 0, len(shortcuts))
+	var updatedShortcut *storepb.ShortcutsUserSetting_Shortcut
 	for _, shortcut := range shortcuts {
 		if shortcut.GetId() == request.Shortcut.GetId() {
+			if request.Shortcut.GetEtag() != "" && request.Shortcut.GetEtag() != getShortcutEtag(shortcut) {
+				return nil, newEtagMismatchError(convertShortcutFromStore(shortcut))
+			}
 			for _, field := range request.UpdateMask.Paths {
 				if field == "title" {
 					if request.Shortcut.GetTitle() == "" {
@@ -175,9 +167,13 @@ func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateS
 					shortcut.Filter = request.Shortcut.GetFilter()
 				}
 			}
+			updatedShortcut = shortcut
 		}
 		newShortcuts = append(newShortcuts, shortcut)
 	}
+	if updatedShortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
 	shortcutsUserSetting.Shortcuts = newShortcuts
 	userSetting.Value = &storepb.UserSetting_Shortcuts{
 		Shortcuts: shortcutsUserSetting,
@@ -187,11 +183,7 @@ func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateS
 		return nil, err
 	}
 
-	return &v1pb.Shortcut{
-		Id:     request.Shortcut.GetId(),
-		Title:  request.Shortcut.GetTitle(),
-		Filter: request.Shortcut.GetFilter(),
-	}, nil
+	return convertShortcutFromStore(updatedShortcut), nil
 }
 
 func (s *APIV1Service) DeleteShortcut(ctx context.Context, request *v1pb.DeleteShortcutRequest) (*emptypb.Empty, error) {
@@ -239,6 +231,21 @@ func (s *APIV1Service) DeleteShortcut(ctx context.Context, request *v1pb.DeleteS
 	return &emptypb.Empty{}, nil
 }
 
+func convertShortcutFromStore(shortcut *storepb.ShortcutsUserSetting_Shortcut) *v1pb.Shortcut {
+	return &v1pb.Shortcut{
+		Id:     shortcut.GetId(),
+		Title:  shortcut.GetTitle(),
+		Filter: shortcut.GetFilter(),
+		Etag:   getShortcutEtag(shortcut),
+	}
+}
+
+// getShortcutEtag returns the etag of the shortcut version. Shortcuts have no timestamps,
+// so the etag is derived from their fields.
+func getShortcutEtag(shortcut *storepb.ShortcutsUserSetting_Shortcut) string {
+	return getEtag(shortcut.GetTitle(), shortcut.GetFilter())
+}
+
 func (s *APIV1Service) validateFilter(_ context.Context, filterStr string) error {
 	if filterStr == "" {
 		return errors.New("filter cannot be empty")
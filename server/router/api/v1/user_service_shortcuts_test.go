@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestUpdateShortcutEtag(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	userCtx := withTestingUser(ctx, user)
+	parent := fmt.Sprintf("%s%d", UserNamePrefix, user.ID)
+	shortcut, err := s.CreateShortcut(userCtx, &v1pb.CreateShortcutRequest{
+		Parent:   parent,
+		Shortcut: &v1pb.Shortcut{Title: "first", Filter: "visibility == \"PUBLIC\""},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, shortcut.Etag)
+
+	renamed, err := s.UpdateShortcut(userCtx, &v1pb.UpdateShortcutRequest{
+		Parent:     parent,
+		Shortcut:   &v1pb.Shortcut{Id: shortcut.Id, Title: "second", Etag: shortcut.Etag},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "second", renamed.Title)
+	require.NotEqual(t, shortcut.Etag, renamed.Etag)
+
+	// An update based on a stale etag is aborted with the current version.
+	_, err = s.UpdateShortcut(userCtx, &v1pb.UpdateShortcutRequest{
+		Parent:     parent,
+		Shortcut:   &v1pb.Shortcut{Id: shortcut.Id, Title: "stale", Etag: shortcut.Etag},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	})
+	requireEtagMismatch(t, err, renamed)
+
+	shortcuts, err := s.ListShortcuts(userCtx, &v1pb.ListShortcutsRequest{Parent: parent})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts.Shortcuts))
+	require.Equal(t, "second", shortcuts.Shortcuts[0].Title)
+}
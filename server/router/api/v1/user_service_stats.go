@@ -58,10 +58,6 @@ func (s *APIV1Service) ListAllUserStats(ctx context.Context, _ *v1pb.ListAllUser
 		}
 		userStats := userStatsMap[creator]
 		userStats.MemoDisplayTimestamps = append(userStats.MemoDisplayTimestamps, timestamppb.New(time.Unix(displayTs, 0)))
-		// Handle duplicated tags.
-		for _, tag := range memo.Payload.Tags {
-			userStats.TagCount[tag]++
-		}
 		if memo.Payload.Property.GetHasLink() {
 			userStats.MemoTypeStats.LinkCount++
 		}
@@ -75,6 +71,19 @@ func (s *APIV1Service) ListAllUserStats(ctx context.Context, _ *v1pb.ListAllUser
 			userStats.MemoTypeStats.UndoCount++
 		}
 	}
+	memoTagCounts, err := s.Store.CountMemoTags(ctx, &store.FindMemoTagCount{
+		ExcludeComments: true,
+		VisibilityList:  visibilities,
+		RowStatus:       &normalStatus,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count memo tags: %v", err)
+	}
+	for _, memoTagCount := range memoTagCounts {
+		if userStats, ok := userStatsMap[fmt.Sprintf("%s%d", UserNamePrefix, memoTagCount.CreatorID)]; ok {
+			userStats.TagCount[memoTagCount.Tag] = memoTagCount.Count
+		}
+	}
 	userStatsList := []*v1pb.UserStats{}
 	for _, userStats := range userStatsMap {
 		userStatsList = append(userStatsList, userStats)
@@ -111,7 +120,18 @@ func (s *APIV1Service) GetUserStats(ctx context.Context, request *v1pb.GetUserSt
 	if currentUser != nil {
 		visibilities = append(visibilities, store.Protected)
 		if currentUser.ID == user.ID {
-			visibilities = append(visibilities, store.Private)
+			visibilities = append(visibilities, store.Followers, store.Private)
+		} else {
+			isFollowing, err := s.Store.IsFollowingUser(ctx, &store.UserFollowing{
+				UserID:          currentUser.ID,
+				FollowingUserID: user.ID,
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to check following status: %v", err)
+			}
+			if isFollowing {
+				visibilities = append(visibilities, store.Followers)
+			}
 		}
 	}
 	memoFind.VisibilityList = visibilities
@@ -136,10 +156,6 @@ func (s *APIV1Service) GetUserStats(ctx context.Context, request *v1pb.GetUserSt
 			displayTs = memo.UpdatedTs
 		}
 		userStats.MemoDisplayTimestamps = append(userStats.MemoDisplayTimestamps, timestamppb.New(time.Unix(displayTs, 0)))
-		// Handle duplicated tags.
-		for _, tag := range memo.Payload.Tags {
-			userStats.TagCount[tag]++
-		}
 		if memo.Payload.Property.GetHasLink() {
 			userStats.MemoTypeStats.LinkCount++
 		}
@@ -153,5 +169,17 @@ func (s *APIV1Service) GetUserStats(ctx context.Context, request *v1pb.GetUserSt
 			userStats.MemoTypeStats.UndoCount++
 		}
 	}
+	memoTagCounts, err := s.Store.CountMemoTags(ctx, &store.FindMemoTagCount{
+		ExcludeComments: true,
+		CreatorID:       &userID,
+		VisibilityList:  visibilities,
+		RowStatus:       &normalStatus,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count memo tags: %v", err)
+	}
+	for _, memoTagCount := range memoTagCounts {
+		userStats.TagCount[memoTagCount.Tag] = memoTagCount.Count
+	}
 	return userStats, nil
 }
@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestUserPrivateProfileFields(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin := createTestingUser(ctx, t, s, "admin", store.RoleHost)
+	user := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	other := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	email, location := "user@usememos.com", "Shanghai"
+	_, err := s.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, Email: &email, Location: &location})
+	require.NoError(t, err)
+	_, err = s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_PROFILE_PRIVACY,
+		Value: &storepb.UserSetting_ProfilePrivacy{
+			ProfilePrivacy: &storepb.ProfilePrivacyUserSetting{PrivateFields: []string{"location"}},
+		},
+	})
+	require.NoError(t, err)
+	userName := getUserPrincipal(user)
+
+	// The hidden fields never match the filters, so their values can't be guessed.
+	for _, filter := range []string{`location == "Shanghai"`, `location.contains("Shang")`} {
+		response, err := s.SearchUsers(ctx, &v1pb.SearchUsersRequest{Filter: filter})
+		require.NoError(t, err)
+		require.Empty(t, response.Users, filter)
+	}
+
+	// The email and the hidden fields are only visible to the user and the admins.
+	for _, testCase := range []struct {
+		ctx     context.Context
+		visible bool
+	}{
+		{ctx: ctx, visible: false},
+		{ctx: withTestingUser(ctx, other), visible: false},
+		{ctx: withTestingUser(ctx, user), visible: true},
+		{ctx: withTestingUser(ctx, admin), visible: true},
+	} {
+		response, err := s.SearchUsers(testCase.ctx, &v1pb.SearchUsersRequest{Filter: `username == "user"`})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(response.Users))
+		userByName, err := s.GetUserByUsername(testCase.ctx, &v1pb.GetUserByUsernameRequest{Username: user.Username})
+		require.NoError(t, err)
+		userByID, err := s.GetUser(testCase.ctx, &v1pb.GetUserRequest{Name: userName})
+		require.NoError(t, err)
+		for _, userMessage := range []*v1pb.User{response.Users[0], userByName, userByID} {
+			if testCase.visible {
+				require.Equal(t, email, userMessage.Email)
+				require.Equal(t, location, userMessage.Location)
+			} else {
+				require.Empty(t, userMessage.Email)
+				require.Empty(t, userMessage.Location)
+			}
+		}
+	}
+}
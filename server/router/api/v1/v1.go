@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
@@ -12,6 +13,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	"github.com/usememos/memos/server/profile"
@@ -24,18 +26,23 @@ type APIV1Service struct {
 	v1pb.UnimplementedAuthServiceServer
 	v1pb.UnimplementedUserServiceServer
 	v1pb.UnimplementedMemoServiceServer
+	v1pb.UnimplementedTagServiceServer
 	v1pb.UnimplementedResourceServiceServer
 	v1pb.UnimplementedInboxServiceServer
 	v1pb.UnimplementedActivityServiceServer
 	v1pb.UnimplementedWebhookServiceServer
 	v1pb.UnimplementedMarkdownServiceServer
 	v1pb.UnimplementedIdentityProviderServiceServer
+	v1pb.UnimplementedEventServiceServer
+	v1pb.UnimplementedChatServiceServer
+	v1pb.UnimplementedGroupServiceServer
 
 	Secret  string
 	Profile *profile.Profile
 	Store   *store.Store
 
 	grpcServer *grpc.Server
+	eventBus   *eventBus
 }
 
 func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, grpcServer *grpc.Server) *APIV1Service {
@@ -45,22 +52,37 @@ func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store
 		Profile:    profile,
 		Store:      store,
 		grpcServer: grpcServer,
+		eventBus:   newEventBus(),
 	}
 	v1pb.RegisterWorkspaceServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterWorkspaceSettingServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterAuthServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterUserServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterMemoServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterTagServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterResourceServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterInboxServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterActivityServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterWebhookServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterMarkdownServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterIdentityProviderServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterEventServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterChatServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterGroupServiceServer(grpcServer, apiv1Service)
 	reflection.Register(grpcServer)
 	return apiv1Service
 }
 
+// withTx runs fn with a copy of the service whose store is bound to a transaction,
+// so the store changes made by fn are either all committed or all rolled back.
+func (s *APIV1Service) withTx(ctx context.Context, fn func(tx *APIV1Service) error) error {
+	return s.Store.WithTx(ctx, func(txStore *store.Store) error {
+		txService := *s
+		txService.Store = txStore
+		return fn(&txService)
+	})
+}
+
 // RegisterGateway registers the gRPC-Gateway with the given Echo instance.
 func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Echo) error {
 	conn, err := grpc.NewClient(
@@ -72,7 +94,26 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 		return err
 	}
 
-	gwMux := runtime.NewServeMux()
+	gwMux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+			if strings.EqualFold(key, IdempotencyKeyHeader) {
+				return IdempotencyKeyHeader, true
+			}
+			return runtime.DefaultHeaderMatcher(key)
+		}),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &httpBodyStreamMarshaler{
+			HTTPBodyMarshaler: runtime.HTTPBodyMarshaler{
+				Marshaler: &runtime.JSONPb{
+					MarshalOptions: protojson.MarshalOptions{
+						EmitUnpopulated: true,
+					},
+					UnmarshalOptions: protojson.UnmarshalOptions{
+						DiscardUnknown: true,
+					},
+				},
+			},
+		}),
+	)
 	if err := v1pb.RegisterWorkspaceServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
@@ -88,6 +129,9 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 	if err := v1pb.RegisterMemoServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
+	if err := v1pb.RegisterTagServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
 	if err := v1pb.RegisterResourceServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
@@ -106,6 +150,12 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 	if err := v1pb.RegisterIdentityProviderServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
+	if err := v1pb.RegisterChatServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterGroupServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
 
 	// 自定义 CORS 配置
 	// corsConfig := middleware.CORSConfig{
@@ -118,6 +168,8 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 	// gwGroup.Use(middleware.CORSWithConfig(corsConfig))
 	handler := echo.WrapHandler(gwMux)
 
+	// The events are streamed to HTTP clients with Server-Sent Events rather than the gateway.
+	gwGroup.GET("/api/v1/events/sse", s.watchEventsSSE)
 	gwGroup.Any("/api/v1/*", handler)
 	gwGroup.Any("/file/*", handler)
 	gwGroup.Any("/u/*", handler)
@@ -134,3 +186,13 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 
 	return nil
 }
+
+// httpBodyStreamMarshaler is the default marshaler of the gateway without the stream delimiter,
+// so the streamed HttpBody chunks, e.g. the memo archive, are written as is.
+type httpBodyStreamMarshaler struct {
+	runtime.HTTPBodyMarshaler
+}
+
+func (*httpBodyStreamMarshaler) Delimiter() []byte {
+	return nil
+}
@@ -0,0 +1,87 @@
+package v1
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/test/store"
+)
+
+func newTestingService(ctx context.Context, t *testing.T) *APIV1Service {
+	ts := teststore.NewTestingStore(ctx, t)
+	t.Cleanup(func() {
+		ts.Close()
+	})
+	return &APIV1Service{
+		Profile:  ts.Profile,
+		Store:    ts,
+		eventBus: newEventBus(),
+	}
+}
+
+func createTestingUser(ctx context.Context, t *testing.T, s *APIV1Service, username string, role store.Role) *store.User {
+	user, err := s.Store.CreateUser(ctx, &store.User{
+		Username: username,
+		Role:     role,
+		Email:    username + "@test.com",
+		Nickname: username + "_nickname",
+	})
+	require.NoError(t, err)
+	return user
+}
+
+// withTestingUser returns the context of the requests authenticated as the user.
+func withTestingUser(ctx context.Context, user *store.User) context.Context {
+	return context.WithValue(ctx, usernameContextKey, user.Username)
+}
+
+// requireEtagMismatch requires the error to be an etag mismatch with the current version in its details.
+func requireEtagMismatch(t *testing.T, err error, current proto.Message) {
+	require.Equal(t, codes.Aborted, status.Code(err))
+	details := status.Convert(err).Details()
+	require.Equal(t, 1, len(details))
+	require.True(t, proto.Equal(current, details[0].(proto.Message)))
+}
+
+// testingHTTPBodyStream collects the chunks of a server streaming HttpBody response.
+type testingHTTPBodyStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	buffer bytes.Buffer
+}
+
+func (s *testingHTTPBodyStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testingHTTPBodyStream) Send(body *httpbody.HttpBody) error {
+	_, err := s.buffer.Write(body.Data)
+	return err
+}
+
+// readTestingZip returns the files of the ZIP archive by their names.
+func readTestingZip(t *testing.T, data []byte) map[string][]byte {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	files := map[string][]byte{}
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		files[file.Name] = content
+	}
+	return files
+}
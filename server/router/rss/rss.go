@@ -73,6 +73,7 @@ func (s *RSSService) GetUserRSS(c echo.Context) error {
 	}
 
 	normalStatus := store.Normal
+	// The feeds are read anonymously, so the PROTECTED and FOLLOWERS memos are never included.
 	memoFind := store.FindMemo{
 		CreatorID:      &user.ID,
 		RowStatus:      &normalStatus,
@@ -0,0 +1,50 @@
+package idempotency
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Schedule runner every hour.
+const runnerInterval = time.Hour
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	r.DeleteExpiredIdempotencyKeys(ctx)
+}
+
+// DeleteExpiredIdempotencyKeys deletes the idempotency keys kept longer than their TTL.
+func (r *Runner) DeleteExpiredIdempotencyKeys(ctx context.Context) {
+	createdTsBefore := time.Now().Add(-store.IdempotencyKeyTTL).Unix()
+	if err := r.Store.DeleteIdempotencyKey(ctx, &store.DeleteIdempotencyKey{
+		CreatedTsBefore: &createdTsBefore,
+	}); err != nil {
+		slog.Error("failed to delete expired idempotency keys", "err", err)
+	}
+}
@@ -0,0 +1,66 @@
+package trash
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Schedule runner every hour.
+const runnerInterval = time.Hour
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	r.PurgeExpiredMemos(ctx)
+}
+
+// PurgeExpiredMemos permanently deletes the memos kept in the trash longer than the retention period.
+func (r *Runner) PurgeExpiredMemos(ctx context.Context) {
+	workspaceMemoRelatedSetting, err := r.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get workspace memo related setting", "err", err)
+		return
+	}
+
+	trashedStatus := store.Trashed
+	trashedTsBefore := time.Now().AddDate(0, 0, -int(workspaceMemoRelatedSetting.TrashRetentionDays)).Unix()
+	memos, err := r.Store.ListMemos(ctx, &store.FindMemo{
+		RowStatus:       &trashedStatus,
+		TrashedTsBefore: &trashedTsBefore,
+		ExcludeContent:  true,
+	})
+	if err != nil {
+		slog.Error("failed to list trashed memos", "err", err)
+		return
+	}
+	for _, memo := range memos {
+		if err := r.Store.PurgeMemo(ctx, memo); err != nil {
+			slog.Error("failed to purge memo", "memo", memo.ID, "err", err)
+		}
+	}
+}
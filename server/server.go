@@ -22,8 +22,10 @@ import (
 	apiv1 "github.com/usememos/memos/server/router/api/v1"
 	"github.com/usememos/memos/server/router/frontend"
 	"github.com/usememos/memos/server/router/rss"
+	"github.com/usememos/memos/server/runner/idempotency"
 	"github.com/usememos/memos/server/runner/memopayload"
 	"github.com/usememos/memos/server/runner/s3presign"
+	"github.com/usememos/memos/server/runner/trash"
 	"github.com/usememos/memos/store"
 )
 
@@ -80,6 +82,10 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 			apiv1.NewLoggerInterceptor().LoggerInterceptor,
 			grpcrecovery.UnaryServerInterceptor(),
 			apiv1.NewGRPCAuthInterceptor(store, secret).AuthenticationInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			grpcrecovery.StreamServerInterceptor(),
+			apiv1.NewGRPCAuthInterceptor(store, secret).AuthenticationStreamInterceptor,
 		))
 	s.grpcServer = grpcServer
 
@@ -146,8 +152,14 @@ func (s *Server) StartBackgroundRunners(ctx context.Context) {
 	memopayloadRunner := memopayload.NewRunner(s.Store)
 	// Rebuild all memos' payload after server starts.
 	memopayloadRunner.RunOnce(ctx)
+	trashRunner := trash.NewRunner(s.Store)
+	trashRunner.RunOnce(ctx)
+	idempotencyRunner := idempotency.NewRunner(s.Store)
+	idempotencyRunner.RunOnce(ctx)
 
 	go s3presignRunner.Run(ctx)
+	go trashRunner.Run(ctx)
+	go idempotencyRunner.Run(ctx)
 }
 
 func (s *Server) getOrUpsertWorkspaceBasicSetting(ctx context.Context) (*storepb.WorkspaceBasicSetting, error) {
@@ -0,0 +1,112 @@
+package store
+
+import (
+	"context"
+)
+
+// ChannelType is the type of a chat channel.
+type ChannelType string
+
+const (
+	// ChannelTypeDirect is a channel of direct messages between two users.
+	ChannelTypeDirect ChannelType = "DIRECT"
+	// ChannelTypeGroup is a channel of a group of users.
+	ChannelTypeGroup ChannelType = "GROUP"
+)
+
+func (t ChannelType) String() string {
+	return string(t)
+}
+
+// Channel is a chat channel, its messages are only visible to its members.
+type Channel struct {
+	ID        int32
+	CreatorID int32
+	CreatedTs int64
+	// UpdatedTs is the time of the last message of the channel.
+	UpdatedTs int64
+
+	Type ChannelType
+	// Name is the name of the group channel.
+	Name string
+}
+
+type FindChannel struct {
+	ID *int32
+	// MemberID finds the channels the user is a member of.
+	MemberID *int32
+	Type     *ChannelType
+}
+
+type UpdateChannel struct {
+	ID        int32
+	UpdatedTs *int64
+	Name      *string
+}
+
+// ChannelMember is a member of a channel, along with how far the member has read the channel.
+type ChannelMember struct {
+	ChannelID int32
+	UserID    int32
+	CreatedTs int64
+	// LastReadMessageID is the id of the last message read by the member, the later ones are unread.
+	LastReadMessageID int32
+}
+
+type FindChannelMember struct {
+	ChannelID     *int32
+	ChannelIDList []int32
+	UserID        *int32
+}
+
+type UpdateChannelMember struct {
+	ChannelID         int32
+	UserID            int32
+	LastReadMessageID *int32
+}
+
+func (s *Store) CreateChannel(ctx context.Context, create *Channel) (*Channel, error) {
+	return s.driver.CreateChannel(ctx, create)
+}
+
+func (s *Store) ListChannels(ctx context.Context, find *FindChannel) ([]*Channel, error) {
+	return s.driver.ListChannels(ctx, find)
+}
+
+func (s *Store) GetChannel(ctx context.Context, find *FindChannel) (*Channel, error) {
+	list, err := s.ListChannels(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateChannel(ctx context.Context, update *UpdateChannel) error {
+	return s.driver.UpdateChannel(ctx, update)
+}
+
+func (s *Store) CreateChannelMember(ctx context.Context, create *ChannelMember) (*ChannelMember, error) {
+	return s.driver.CreateChannelMember(ctx, create)
+}
+
+func (s *Store) ListChannelMembers(ctx context.Context, find *FindChannelMember) ([]*ChannelMember, error) {
+	return s.driver.ListChannelMembers(ctx, find)
+}
+
+func (s *Store) GetChannelMember(ctx context.Context, find *FindChannelMember) (*ChannelMember, error) {
+	list, err := s.ListChannelMembers(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateChannelMember(ctx context.Context, update *UpdateChannelMember) error {
+	return s.driver.UpdateChannelMember(ctx, update)
+}
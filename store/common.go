@@ -17,8 +17,19 @@ const (
 	Normal RowStatus = "NORMAL"
 	// Archived is the status for an archived row.
 	Archived RowStatus = "ARCHIVED"
+	// Trashed is the status for a row in the trash.
+	Trashed RowStatus = "TRASHED"
 )
 
 func (r RowStatus) String() string {
 	return string(r)
 }
+
+// Cursor is the sort key of the last row of the previous page, used for keyset pagination.
+type Cursor struct {
+	ID int32
+	// Ts is the timestamp the rows are sorted by.
+	Ts int64
+	// Pinned is only used when the rows are ordered by pinned.
+	Pinned bool
+}
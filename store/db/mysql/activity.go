@@ -25,7 +25,7 @@ func (d *DB) CreateActivity(ctx context.Context, create *store.Activity) (*store
 	args := []any{create.CreatorID, create.Type.String(), create.Level.String(), payloadString}
 
 	stmt := "INSERT INTO `activity` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to execute statement")
 	}
@@ -56,7 +56,7 @@ func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*s
 	}
 
 	query := "SELECT `id`, `creator_id`, `type`, `level`, `payload`, UNIX_TIMESTAMP(`created_ts`) FROM `activity` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC"
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
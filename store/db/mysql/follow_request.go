@@ -0,0 +1,82 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateFollowRequest(ctx context.Context, create *store.FollowRequest) (*store.FollowRequest, error) {
+	fields := []string{"`requester_id`", "`user_id`"}
+	placeholder := []string{"?", "?"}
+	args := []any{create.RequesterID, create.UserID}
+
+	stmt := "INSERT INTO `follow_request` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	rawID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	id := int32(rawID)
+	list, err := d.ListFollowRequests(ctx, &store.FindFollowRequest{ID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("failed to create follow request")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListFollowRequests(ctx context.Context, find *store.FindFollowRequest) ([]*store.FollowRequest, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := find.RequesterID; v != nil {
+		where, args = append(where, "`requester_id` = ?"), append(args, *v)
+	}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *v)
+	}
+
+	rows, err := d.conn.QueryContext(ctx, "SELECT `id`, UNIX_TIMESTAMP(`created_ts`), `requester_id`, `user_id` FROM `follow_request` WHERE "+strings.Join(where, " AND ")+" ORDER BY `created_ts` DESC, `id` DESC", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.FollowRequest{}
+	for rows.Next() {
+		followRequest := &store.FollowRequest{}
+		if err := rows.Scan(
+			&followRequest.ID,
+			&followRequest.CreatedTs,
+			&followRequest.RequesterID,
+			&followRequest.UserID,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, followRequest)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteFollowRequest(ctx context.Context, delete *store.DeleteFollowRequest) error {
+	if _, err := d.conn.ExecContext(ctx, "DELETE FROM `follow_request` WHERE `id` = ?", delete.ID); err != nil {
+		return err
+	}
+	return nil
+}
@@ -16,7 +16,7 @@ func (d *DB) CreateIdentityProvider(ctx context.Context, create *store.IdentityP
 	args := []any{create.Name, create.Type.String(), create.IdentifierFilter, create.Config}
 
 	stmt := "INSERT INTO `idp` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholders, ", ") + ")"
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +36,7 @@ func (d *DB) ListIdentityProviders(ctx context.Context, find *store.FindIdentity
 		where, args = append(where, "`id` = ?"), append(args, *v)
 	}
 
-	rows, err := d.db.QueryContext(ctx, "SELECT `id`, `name`, `type`, `identifier_filter`, `config` FROM `idp` WHERE "+strings.Join(where, " AND ")+" ORDER BY `id` ASC",
+	rows, err := d.conn.QueryContext(ctx, "SELECT `id`, `name`, `type`, `identifier_filter`, `config` FROM `idp` WHERE "+strings.Join(where, " AND ")+" ORDER BY `id` ASC",
 		args...,
 	)
 	if err != nil {
@@ -95,7 +95,7 @@ func (d *DB) UpdateIdentityProvider(ctx context.Context, update *store.UpdateIde
 	args = append(args, update.ID)
 
 	stmt := "UPDATE `idp` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
-	_, err := d.db.ExecContext(ctx, stmt, args...)
+	_, err := d.conn.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +115,7 @@ func (d *DB) UpdateIdentityProvider(ctx context.Context, update *store.UpdateIde
 func (d *DB) DeleteIdentityProvider(ctx context.Context, delete *store.DeleteIdentityProvider) error {
 	where, args := []string{"`id` = ?"}, []any{delete.ID}
 	stmt := "DELETE FROM `idp` WHERE " + strings.Join(where, " AND ")
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return err
 	}
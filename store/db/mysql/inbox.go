@@ -27,7 +27,7 @@ func (d *DB) CreateInbox(ctx context.Context, create *store.Inbox) (*store.Inbox
 	args := []any{create.SenderID, create.ReceiverID, create.Status, messageString}
 
 	stmt := "INSERT INTO `inbox` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -60,15 +60,18 @@ func (d *DB) ListInboxes(ctx context.Context, find *store.FindInbox) ([]*store.I
 	if find.Status != nil {
 		where, args = append(where, "`status` = ?"), append(args, *find.Status)
 	}
+	if v := find.Cursor; v != nil {
+		where, args = append(where, "(UNIX_TIMESTAMP(`created_ts`) < ? OR (UNIX_TIMESTAMP(`created_ts`) = ? AND `id` < ?))"), append(args, v.Ts, v.Ts, v.ID)
+	}
 
-	query := "SELECT `id`, UNIX_TIMESTAMP(`created_ts`), `sender_id`, `receiver_id`, `status`, `message` FROM `inbox` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC"
+	query := "SELECT `id`, UNIX_TIMESTAMP(`created_ts`), `sender_id`, `receiver_id`, `status`, `message` FROM `inbox` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC, `id` DESC"
 	if find.Limit != nil {
 		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
 		if find.Offset != nil {
 			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
 		}
 	}
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -119,7 +122,7 @@ func (d *DB) UpdateInbox(ctx context.Context, update *store.UpdateInbox) (*store
 	set, args := []string{"`status` = ?"}, []any{update.Status.String()}
 	args = append(args, update.ID)
 	query := "UPDATE `inbox` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
-	if _, err := d.db.ExecContext(ctx, query, args...); err != nil {
+	if _, err := d.conn.ExecContext(ctx, query, args...); err != nil {
 		return nil, errors.Wrap(err, "failed to update inbox")
 	}
 	inbox, err := d.GetInbox(ctx, &store.FindInbox{ID: &update.ID})
@@ -130,7 +133,7 @@ func (d *DB) UpdateInbox(ctx context.Context, update *store.UpdateInbox) (*store
 }
 
 func (d *DB) DeleteInbox(ctx context.Context, delete *store.DeleteInbox) error {
-	result, err := d.db.ExecContext(ctx, "DELETE FROM `inbox` WHERE `id` = ?", delete.ID)
+	result, err := d.conn.ExecContext(ctx, "DELETE FROM `inbox` WHERE `id` = ?", delete.ID)
 	if err != nil {
 		return errors.Wrap(err, "failed to delete inbox")
 	}
@@ -5,18 +5,20 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/usememos/memos/plugin/filter"
+	"github.com/usememos/memos/plugin/search"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
 func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, error) {
-	fields := []string{"`uid`", "`creator_id`", "`content`", "`visibility`", "`payload`"}
-	placeholder := []string{"?", "?", "?", "?", "?"}
+	fields := []string{"`uid`", "`creator_id`", "`content`", "`visibility`", "`payload`", "`changed_ts`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?"}
 	payload := "{}"
 	if create.Payload != nil {
 		payloadBytes, err := protojson.Marshal(create.Payload)
@@ -25,10 +27,10 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 		}
 		payload = string(payloadBytes)
 	}
-	args := []any{create.UID, create.CreatorID, create.Content, create.Visibility, payload}
+	args := []any{create.UID, create.CreatorID, create.Content, create.Visibility, payload, time.Now().Unix()}
 
 	stmt := "INSERT INTO `memo` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -54,18 +56,35 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.ID; v != nil {
 		where, args = append(where, "`memo`.`id` = ?"), append(args, *v)
 	}
+	if v := find.IDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders = append(holders, "?")
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("`memo`.`id` IN (%s)", strings.Join(holders, ", ")))
+	}
 	if v := find.UID; v != nil {
 		where, args = append(where, "`memo`.`uid` = ?"), append(args, *v)
 	}
 	log.Printf("Exporting memos with CreatorID: %v", find.CreatorID)
 	if v := find.CreatorID; v != nil {
 		if find.IsFollow {
-			where = append(where, "`memo`.`creator_id` IN (SELECT `following_user_id` FROM `user_following` WHERE `user_id` = ?)")
-			args = append(args, *v)
+			if tag := find.FollowTag; tag != nil {
+				where = append(where, "`memo`.`creator_id` IN (SELECT `following_user_id` FROM `user_following` WHERE `user_id` = ? AND `tag` = ?)")
+				args = append(args, *v, *tag)
+			} else {
+				where = append(where, "`memo`.`creator_id` IN (SELECT `following_user_id` FROM `user_following` WHERE `user_id` = ?)")
+				args = append(args, *v)
+			}
 		} else {
 			where, args = append(where, "`memo`.`creator_id` = ?"), append(args, *v)
 		}
 	}
+	if v := find.MuterID; v != nil {
+		where = append(where, "`memo`.`creator_id` NOT IN (SELECT `blocked_user_id` FROM `user_block` WHERE `user_id` = ? AND `type` = ?)")
+		args = append(args, *v, store.UserBlockTypeMute.String())
+	}
 	if v := find.RowStatus; v != nil {
 		where, args = append(where, "`memo`.`row_status` = ?"), append(args, *v)
 	}
@@ -76,14 +95,23 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		where, args = append(where, "UNIX_TIMESTAMP(`memo`.`created_ts`) > ?"), append(args, *v)
 	}
 	if v := find.UpdatedTsBefore; v != nil {
-		where, args = append(where, "UNIX_TIMESTAMP(`memo`.`updated_ts`) < ?"), append(args, *v)
+		where, args = append(where, "`memo`.`updated_ts` < FROM_UNIXTIME(?)"), append(args, *v)
 	}
 	if v := find.UpdatedTsAfter; v != nil {
-		where, args = append(where, "UNIX_TIMESTAMP(`memo`.`updated_ts`) > ?"), append(args, *v)
+		// Compare the column itself so the index is used.
+		where, args = append(where, "`memo`.`updated_ts` > FROM_UNIXTIME(?)"), append(args, *v)
+	}
+	if v := find.ChangedTsAfter; v != nil {
+		where, args = append(where, "`memo`.`changed_ts` > ?"), append(args, *v)
+	}
+	if v := find.TrashedTsBefore; v != nil {
+		where, args = append(where, "`memo`.`trashed_ts` < ?"), append(args, *v)
 	}
 	if v := find.ContentSearch; len(v) != 0 {
 		for _, s := range v {
-			where, args = append(where, "`memo`.`content` LIKE ?"), append(args, "%"+s+"%")
+			for _, token := range search.Tokenize(s) {
+				where, args = append(where, "`memo`.`content` LIKE ?"), append(args, "%"+token+"%")
+			}
 		}
 	}
 	if v := find.VisibilityList; len(v) != 0 {
@@ -92,7 +120,20 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			placeholder = append(placeholder, "?")
 			args = append(args, visibility.String())
 		}
-		where = append(where, fmt.Sprintf("`memo`.`visibility` in (%s)", strings.Join(placeholder, ",")))
+		condition := fmt.Sprintf("`memo`.`visibility` in (%s)", strings.Join(placeholder, ","))
+		if principals := find.ACLPrincipals; len(principals) != 0 {
+			holders := []string{}
+			for _, principal := range principals {
+				holders = append(holders, "?")
+				args = append(args, principal)
+			}
+			condition = fmt.Sprintf("(%s OR `memo`.`id` IN (SELECT `memo_id` FROM `memo_acl` WHERE `principal` IN (%s)))", condition, strings.Join(holders, ", "))
+		}
+		if v := find.FollowerID; v != nil {
+			condition = fmt.Sprintf("(%s OR (`memo`.`visibility` = ? AND `memo`.`creator_id` IN (SELECT `following_user_id` FROM `user_following` WHERE `user_id` = ?)))", condition)
+			args = append(args, store.Followers.String(), *v)
+		}
+		where = append(where, condition)
 	}
 	if v := find.PayloadFind; v != nil {
 		if v.Raw != nil {
@@ -100,7 +141,8 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		}
 		if len(v.TagSearch) != 0 {
 			for _, tag := range v.TagSearch {
-				where, args = append(where, "(JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?))"), append(args, fmt.Sprintf(`"%s"`, tag), fmt.Sprintf(`"%s/"`, tag))
+				// Match the tag and its sub tags.
+				where, args = append(where, "`memo`.`id` IN (SELECT `memo_id` FROM `memo_tag` WHERE `tag` = ? OR `tag` LIKE ?)"), append(args, tag, escapeLikePattern(tag)+"/%")
 			}
 		}
 		if v.HasLink {
@@ -137,6 +179,30 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.ExcludeComments {
 		having = append(having, "`parent_id` IS NULL")
 	}
+	if find.ExcludeTrashedWithParent {
+		where = append(where, "NOT EXISTS (SELECT 1 FROM `memo` AS `parent` WHERE `parent`.`id` = `memo_relation`.`related_memo_id` AND `parent`.`row_status` = 'TRASHED' AND `parent`.`trashed_ts` = `memo`.`trashed_ts`)")
+	}
+	if v := find.Cursor; v != nil {
+		operator := "<"
+		if find.OrderByTimeAsc {
+			operator = ">"
+		}
+		tsField, tsValue := "`memo`.`created_ts`", "FROM_UNIXTIME(?)"
+		if find.OrderByChangedTs {
+			// The change time is stored in seconds.
+			tsField, tsValue = "`memo`.`changed_ts`", "?"
+		} else if find.OrderByUpdatedTs {
+			tsField = "`memo`.`updated_ts`"
+		}
+		condition := fmt.Sprintf("(%s %s %s OR (%s = %s AND `memo`.`id` %s ?))", tsField, operator, tsValue, tsField, tsValue, operator)
+		conditionArgs := []any{v.Ts, v.Ts, v.ID}
+		if find.OrderByPinned {
+			// Pinned memos always come first.
+			condition = fmt.Sprintf("(`memo`.`pinned` < ? OR (`memo`.`pinned` = ? AND %s))", condition)
+			conditionArgs = append([]any{v.Pinned, v.Pinned}, conditionArgs...)
+		}
+		where, args = append(where, condition), append(args, conditionArgs...)
+	}
 
 	orders := []string{}
 	if find.OrderByPinned {
@@ -146,7 +212,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.OrderByTimeAsc {
 		order = "ASC"
 	}
-	if find.OrderByUpdatedTs {
+	if find.OrderByChangedTs {
+		orders = append(orders, "`changed_ts` "+order)
+	} else if find.OrderByUpdatedTs {
 		orders = append(orders, "`updated_ts` "+order)
 	} else {
 		orders = append(orders, "`created_ts` "+order)
@@ -158,10 +226,13 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		"`memo`.`creator_id` AS `creator_id`",
 		"UNIX_TIMESTAMP(`memo`.`created_ts`) AS `created_ts`",
 		"UNIX_TIMESTAMP(`memo`.`updated_ts`) AS `updated_ts`",
+		"`memo`.`trashed_ts` AS `trashed_ts`",
 		"`memo`.`row_status` AS `row_status`",
 		"`memo`.`visibility` AS `visibility`",
 		"`memo`.`pinned` AS `pinned`",
 		"`memo`.`payload` AS `payload`",
+		"`memo`.`version` AS `version`",
+		"`memo`.`changed_ts` AS `changed_ts`",
 		"`memo_relation`.`related_memo_id` AS `parent_id`",
 	}
 	if !find.ExcludeContent {
@@ -180,7 +251,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		}
 	}
 
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -196,10 +267,13 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			&memo.CreatorID,
 			&memo.CreatedTs,
 			&memo.UpdatedTs,
+			&memo.TrashedTs,
 			&memo.RowStatus,
 			&memo.Visibility,
 			&memo.Pinned,
 			&payloadBytes,
+			&memo.Version,
+			&memo.ChangedTs,
 			&memo.ParentID,
 		}
 		if !find.ExcludeContent {
@@ -247,6 +321,9 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	if v := update.UpdatedTs; v != nil {
 		set, args = append(set, "`updated_ts` = FROM_UNIXTIME(?)"), append(args, *v)
 	}
+	if v := update.TrashedTs; v != nil {
+		set, args = append(set, "`trashed_ts` = ?"), append(args, *v)
+	}
 	if v := update.RowStatus; v != nil {
 		set, args = append(set, "`row_status` = ?"), append(args, *v)
 	}
@@ -269,19 +346,35 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	if len(set) == 0 {
 		return nil
 	}
+	// The version always changes, so the row is affected whenever it matches.
+	set, args = append(set, "`version` = `version` + 1", "`changed_ts` = ?"), append(args, time.Now().Unix())
+	where := []string{"`id` = ?"}
 	args = append(args, update.ID)
+	if v := update.Version; v != nil {
+		where, args = append(where, "`version` = ?"), append(args, *v)
+	}
 
-	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE " + strings.Join(where, " AND ")
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
+	if err != nil {
 		return err
 	}
+	if update.Version != nil {
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return store.ErrMemoVersionMismatch
+		}
+	}
 	return nil
 }
 
 func (d *DB) DeleteMemo(ctx context.Context, delete *store.DeleteMemo) error {
 	where, args := []string{"`id` = ?"}, []any{delete.ID}
 	stmt := "DELETE FROM `memo` WHERE " + strings.Join(where, " AND ")
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	exprv1 "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
 
 	"github.com/usememos/memos/plugin/filter"
+	"github.com/usememos/memos/plugin/search"
 )
 
 func (d *DB) ConvertExprToSQL(ctx *filter.ConvertContext, expr *exprv1.Expr) error {
@@ -143,21 +144,14 @@ func (d *DB) ConvertExprToSQL(ctx *filter.ConvertContext, expr *exprv1.Expr) err
 				values = append(values, value)
 			}
 			if identifier == "tag" {
-				subcodition := []string{}
-				args := []any{}
-				for _, v := range values {
-					subcodition, args = append(subcodition, "JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?)"), append(args, v)
+				holders := []string{}
+				for range values {
+					holders = append(holders, "?")
 				}
-				if len(subcodition) == 1 {
-					if _, err := ctx.Buffer.WriteString(subcodition[0]); err != nil {
-						return err
-					}
-				} else {
-					if _, err := ctx.Buffer.WriteString(fmt.Sprintf("(%s)", strings.Join(subcodition, " OR "))); err != nil {
-						return err
-					}
+				if _, err := ctx.Buffer.WriteString(fmt.Sprintf("`memo`.`id` IN (SELECT `memo_id` FROM `memo_tag` WHERE `tag` IN (%s))", strings.Join(holders, ", "))); err != nil {
+					return err
 				}
-				ctx.Args = append(ctx.Args, args...)
+				ctx.Args = append(ctx.Args, values...)
 			} else if identifier == "visibility" {
 				placeholder := []string{}
 				for range values {
@@ -183,10 +177,25 @@ func (d *DB) ConvertExprToSQL(ctx *filter.ConvertContext, expr *exprv1.Expr) err
 			if err != nil {
 				return err
 			}
-			if _, err := ctx.Buffer.WriteString("`memo`.`content` LIKE ?"); err != nil {
+			argStr, ok := arg.(string)
+			if !ok {
+				return errors.New("invalid string value")
+			}
+			// The content must contain every token of the argument.
+			conditions := []string{}
+			for _, token := range search.Tokenize(argStr) {
+				conditions = append(conditions, "`memo`.`content` LIKE ?")
+				ctx.Args = append(ctx.Args, fmt.Sprintf("%%%s%%", token))
+			}
+			condition := "1 = 1"
+			if len(conditions) == 1 {
+				condition = conditions[0]
+			} else if len(conditions) > 1 {
+				condition = fmt.Sprintf("(%s)", strings.Join(conditions, " AND "))
+			}
+			if _, err := ctx.Buffer.WriteString(condition); err != nil {
 				return err
 			}
-			ctx.Args = append(ctx.Args, fmt.Sprintf("%%%s%%", arg))
 		}
 	}
 	return nil
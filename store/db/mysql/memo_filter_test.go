@@ -16,12 +16,12 @@ func TestConvertExprToSQL(t *testing.T) {
 	}{
 		{
 			filter: `tag in ["tag1", "tag2"]`,
-			want:   "(JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?))",
+			want:   "`memo`.`id` IN (SELECT `memo_id` FROM `memo_tag` WHERE `tag` IN (?, ?))",
 			args:   []any{"tag1", "tag2"},
 		},
 		{
 			filter: `!(tag in ["tag1", "tag2"])`,
-			want:   "NOT ((JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?)))",
+			want:   "NOT (`memo`.`id` IN (SELECT `memo_id` FROM `memo_tag` WHERE `tag` IN (?, ?)))",
 			args:   []any{"tag1", "tag2"},
 		},
 		{
@@ -46,7 +46,7 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `tag in ['tag1'] || content.contains('hello')`,
-			want:   "(JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR `memo`.`content` LIKE ?)",
+			want:   "(`memo`.`id` IN (SELECT `memo_id` FROM `memo_tag` WHERE `tag` IN (?)) OR `memo`.`content` LIKE ?)",
 			args:   []any{"tag1", "%hello%"},
 		},
 	}
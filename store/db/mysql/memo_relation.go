@@ -2,6 +2,7 @@ package mysql
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/usememos/memos/store"
@@ -9,7 +10,7 @@ import (
 
 func (d *DB) UpsertMemoRelation(ctx context.Context, create *store.MemoRelation) (*store.MemoRelation, error) {
 	stmt := "INSERT INTO `memo_relation` (`memo_id`, `related_memo_id`, `type`) VALUES (?, ?, ?)"
-	_, err := d.db.ExecContext(
+	_, err := d.conn.ExecContext(
 		ctx,
 		stmt,
 		create.MemoID,
@@ -34,14 +35,30 @@ func (d *DB) ListMemoRelations(ctx context.Context, find *store.FindMemoRelation
 	if find.MemoID != nil {
 		where, args = append(where, "`memo_id` = ?"), append(args, find.MemoID)
 	}
+	if v := find.MemoIDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders = append(holders, "?")
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("`memo_id` IN (%s)", strings.Join(holders, ", ")))
+	}
 	if find.RelatedMemoID != nil {
 		where, args = append(where, "`related_memo_id` = ?"), append(args, find.RelatedMemoID)
 	}
+	if v := find.RelatedMemoIDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders = append(holders, "?")
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("`related_memo_id` IN (%s)", strings.Join(holders, ", ")))
+	}
 	if find.Type != nil {
 		where, args = append(where, "`type` = ?"), append(args, find.Type)
 	}
 
-	rows, err := d.db.QueryContext(ctx, "SELECT `memo_id`, `related_memo_id`, `type` FROM `memo_relation` WHERE "+strings.Join(where, " AND "), args...)
+	rows, err := d.conn.QueryContext(ctx, "SELECT `memo_id`, `related_memo_id`, `type` FROM `memo_relation` WHERE "+strings.Join(where, " AND "), args...)
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +96,7 @@ func (d *DB) DeleteMemoRelation(ctx context.Context, delete *store.DeleteMemoRel
 		where, args = append(where, "`type` = ?"), append(args, delete.Type)
 	}
 	stmt := "DELETE FROM `memo_relation` WHERE " + strings.Join(where, " AND ")
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return err
 	}
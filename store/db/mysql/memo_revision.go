@@ -0,0 +1,89 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoRevision(ctx context.Context, create *store.MemoRevision) (*store.MemoRevision, error) {
+	fields := []string{"`memo_id`", "`creator_id`", "`content`", "`visibility`"}
+	placeholder := []string{"?", "?", "?", "?"}
+	args := []any{create.MemoID, create.CreatorID, create.Content, create.Visibility}
+
+	stmt := "INSERT INTO `memo_revision` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	rawID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	id := int32(rawID)
+	list, err := d.ListMemoRevisions(ctx, &store.FindMemoRevision{ID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("failed to create memo revision")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListMemoRevisions(ctx context.Context, find *store.FindMemoRevision) ([]*store.MemoRevision, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := find.MemoID; v != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *v)
+	}
+
+	rows, err := d.conn.QueryContext(ctx, "SELECT `id`, `memo_id`, `creator_id`, UNIX_TIMESTAMP(`created_ts`), `content`, `visibility` FROM `memo_revision` WHERE "+strings.Join(where, " AND ")+" ORDER BY `id` DESC", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoRevision{}
+	for rows.Next() {
+		memoRevision := &store.MemoRevision{}
+		if err := rows.Scan(
+			&memoRevision.ID,
+			&memoRevision.MemoID,
+			&memoRevision.CreatorID,
+			&memoRevision.CreatedTs,
+			&memoRevision.Content,
+			&memoRevision.Visibility,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, memoRevision)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteMemoRevision(ctx context.Context, delete *store.DeleteMemoRevision) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := delete.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := delete.MemoID; v != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *v)
+	}
+	stmt := "DELETE FROM `memo_revision` WHERE " + strings.Join(where, " AND ")
+	if _, err := d.conn.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/search"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) SearchMemos(ctx context.Context, find *store.SearchMemo) ([]*store.MemoSearchResult, error) {
+	terms := search.Tokenize(find.Query)
+	if len(terms) == 0 {
+		return []*store.MemoSearchResult{}, nil
+	}
+
+	// Every term must match as a phrase of the ngram parser tokens.
+	againstQuery := buildAgainstQuery(terms)
+	where, args := []string{"MATCH(`memo`.`content`) AGAINST(? IN BOOLEAN MODE)"}, []any{againstQuery}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "`memo`.`creator_id` = ?"), append(args, *v)
+	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, "`memo`.`row_status` = ?"), append(args, *v)
+	}
+	if v := find.MuterID; v != nil {
+		where = append(where, "`memo`.`creator_id` NOT IN (SELECT `blocked_user_id` FROM `user_block` WHERE `user_id` = ? AND `type` = ?)")
+		args = append(args, *v, store.UserBlockTypeMute.String())
+	}
+	if v := find.VisibilityList; len(v) != 0 {
+		placeholder := []string{}
+		for _, visibility := range v {
+			placeholder = append(placeholder, "?")
+			args = append(args, visibility.String())
+		}
+		condition := fmt.Sprintf("`memo`.`visibility` IN (%s)", strings.Join(placeholder, ","))
+		if principals := find.ACLPrincipals; len(principals) != 0 {
+			holders := []string{}
+			for _, principal := range principals {
+				holders = append(holders, "?")
+				args = append(args, principal)
+			}
+			condition = fmt.Sprintf("(%s OR `memo`.`id` IN (SELECT `memo_id` FROM `memo_acl` WHERE `principal` IN (%s)))", condition, strings.Join(holders, ", "))
+		}
+		if v := find.FollowerID; v != nil {
+			condition = fmt.Sprintf("(%s OR (`memo`.`visibility` = ? AND `memo`.`creator_id` IN (SELECT `following_user_id` FROM `user_following` WHERE `user_id` = ?)))", condition)
+			args = append(args, store.Followers.String(), *v)
+		}
+		where = append(where, condition)
+	}
+	if find.ExcludeComments {
+		where = append(where, "`memo_relation`.`related_memo_id` IS NULL")
+	}
+
+	fields := []string{
+		"`memo`.`id` AS `id`",
+		"`memo`.`uid` AS `uid`",
+		"`memo`.`creator_id` AS `creator_id`",
+		"UNIX_TIMESTAMP(`memo`.`created_ts`) AS `created_ts`",
+		"UNIX_TIMESTAMP(`memo`.`updated_ts`) AS `updated_ts`",
+		"`memo`.`row_status` AS `row_status`",
+		"`memo`.`visibility` AS `visibility`",
+		"`memo`.`pinned` AS `pinned`",
+		"`memo`.`payload` AS `payload`",
+		"`memo`.`version` AS `version`",
+		"`memo`.`changed_ts` AS `changed_ts`",
+		"`memo_relation`.`related_memo_id` AS `parent_id`",
+		"`memo`.`content` AS `content`",
+		"MATCH(`memo`.`content`) AGAINST(? IN BOOLEAN MODE) AS `score`",
+	}
+	// The score placeholder comes before the where conditions.
+	args = append([]any{againstQuery}, args...)
+	query := "SELECT " + strings.Join(fields, ", ") + " FROM `memo` " +
+		"LEFT JOIN `memo_relation` ON `memo`.`id` = `memo_relation`.`memo_id` AND `memo_relation`.`type` = 'COMMENT' " +
+		"WHERE " + strings.Join(where, " AND ") + " " +
+		"ORDER BY `score` DESC, `id` DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+
+	rows, err := d.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]*store.MemoSearchResult, 0)
+	for rows.Next() {
+		var memo store.Memo
+		var payloadBytes []byte
+		result := &store.MemoSearchResult{Memo: &memo}
+		if err := rows.Scan(
+			&memo.ID,
+			&memo.UID,
+			&memo.CreatorID,
+			&memo.CreatedTs,
+			&memo.UpdatedTs,
+			&memo.RowStatus,
+			&memo.Visibility,
+			&memo.Pinned,
+			&payloadBytes,
+			&memo.Version,
+			&memo.ChangedTs,
+			&memo.ParentID,
+			&memo.Content,
+			&result.Score,
+		); err != nil {
+			return nil, err
+		}
+		payload := &storepb.MemoPayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal payload")
+		}
+		memo.Payload = payload
+		list = append(list, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// buildAgainstQuery converts the search terms into a boolean mode query requiring every term.
+// Each term is quoted so that boolean operators in user input are matched literally.
+func buildAgainstQuery(terms []string) string {
+	list := []string{}
+	for _, term := range terms {
+		list = append(list, `+"`+strings.ReplaceAll(term, `"`, " ")+`"`)
+	}
+	return strings.Join(list, " ")
+}
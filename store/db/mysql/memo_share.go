@@ -0,0 +1,107 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoShare(ctx context.Context, create *store.MemoShare) (*store.MemoShare, error) {
+	fields := []string{"`memo_id`", "`creator_id`", "`token`", "`expires_ts`", "`max_views`"}
+	placeholder := []string{"?", "?", "?", "?", "?"}
+	args := []any{create.MemoID, create.CreatorID, create.Token, create.ExpiresTs, create.MaxViews}
+
+	stmt := "INSERT INTO `memo_share` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	rawID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	id := int32(rawID)
+	list, err := d.ListMemoShares(ctx, &store.FindMemoShare{ID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("failed to create memo share")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListMemoShares(ctx context.Context, find *store.FindMemoShare) ([]*store.MemoShare, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := find.MemoID; v != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *v)
+	}
+	if v := find.Token; v != nil {
+		where, args = append(where, "`token` = ?"), append(args, *v)
+	}
+
+	rows, err := d.conn.QueryContext(ctx, "SELECT `id`, `memo_id`, `creator_id`, UNIX_TIMESTAMP(`created_ts`), `token`, `expires_ts`, `max_views`, `view_count` FROM `memo_share` WHERE "+strings.Join(where, " AND ")+" ORDER BY `id` DESC", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoShare{}
+	for rows.Next() {
+		memoShare := &store.MemoShare{}
+		if err := rows.Scan(
+			&memoShare.ID,
+			&memoShare.MemoID,
+			&memoShare.CreatorID,
+			&memoShare.CreatedTs,
+			&memoShare.Token,
+			&memoShare.ExpiresTs,
+			&memoShare.MaxViews,
+			&memoShare.ViewCount,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, memoShare)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) IncreaseMemoShareViewCount(ctx context.Context, id int32) (bool, error) {
+	stmt := "UPDATE `memo_share` SET `view_count` = `view_count` + 1 WHERE `id` = ? AND (`max_views` = 0 OR `view_count` < `max_views`)"
+	result, err := d.conn.ExecContext(ctx, stmt, id)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (d *DB) DeleteMemoShare(ctx context.Context, delete *store.DeleteMemoShare) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := delete.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := delete.MemoID; v != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *v)
+	}
+	stmt := "DELETE FROM `memo_share` WHERE " + strings.Join(where, " AND ")
+	if _, err := d.conn.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
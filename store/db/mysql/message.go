@@ -0,0 +1,108 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMessage(ctx context.Context, create *store.Message) (*store.Message, error) {
+	fields := []string{"`channel_id`", "`creator_id`", "`content`", "`memo_id`"}
+	placeholder := []string{"?", "?", "?", "?"}
+	args := []any{create.ChannelID, create.CreatorID, create.Content, create.MemoID}
+
+	stmt := "INSERT INTO `message` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	rawID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	id := int32(rawID)
+	list, err := d.ListMessages(ctx, &store.FindMessage{ID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("failed to create message")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListMessages(ctx context.Context, find *store.FindMessage) ([]*store.Message, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := find.ChannelID; v != nil {
+		where, args = append(where, "`channel_id` = ?"), append(args, *v)
+	}
+	if v := find.Cursor; v != nil {
+		where, args = append(where, "`id` < ?"), append(args, v.ID)
+	}
+
+	query := "SELECT `id`, `channel_id`, `creator_id`, UNIX_TIMESTAMP(`created_ts`), `content`, `memo_id` FROM `message` WHERE " + strings.Join(where, " AND ") + " ORDER BY `id` DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+	}
+	rows, err := d.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Message{}
+	for rows.Next() {
+		message := &store.Message{}
+		if err := rows.Scan(
+			&message.ID,
+			&message.ChannelID,
+			&message.CreatorID,
+			&message.CreatedTs,
+			&message.Content,
+			&message.MemoID,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, message)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) CountUnreadMessages(ctx context.Context, userID int32) (map[int32]int32, error) {
+	query := "SELECT `channel_member`.`channel_id`, COUNT(`message`.`id`) FROM `channel_member` " +
+		"JOIN `message` ON `message`.`channel_id` = `channel_member`.`channel_id` AND `message`.`id` > `channel_member`.`last_read_message_id` AND `message`.`creator_id` != `channel_member`.`user_id` " +
+		"WHERE `channel_member`.`user_id` = ? GROUP BY `channel_member`.`channel_id`"
+	rows, err := d.conn.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := map[int32]int32{}
+	for rows.Next() {
+		var channelID, count int32
+		if err := rows.Scan(&channelID, &count); err != nil {
+			return nil, err
+		}
+		counts[channelID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
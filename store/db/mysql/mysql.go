@@ -1,6 +1,7 @@
 package mysql
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/go-sql-driver/mysql"
@@ -11,7 +12,10 @@ import (
 )
 
 type DB struct {
-	db      *sql.DB
+	db *sql.DB
+	// conn runs the queries, it is either db or tx.
+	conn    store.DBTX
+	tx      *sql.Tx
 	profile *profile.Profile
 	config  *mysql.Config
 }
@@ -35,6 +39,7 @@ func NewDB(profile *profile.Profile) (store.Driver, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to open db: %s", profile.DSN)
 	}
+	driver.conn = driver.db
 
 	return &driver, nil
 }
@@ -44,9 +49,40 @@ func (d *DB) GetDB() *sql.DB {
 }
 
 func (d *DB) Close() error {
+	if d.tx != nil {
+		return errors.New("cannot close the database in a transaction")
+	}
 	return d.db.Close()
 }
 
+func (d *DB) BeginTx(ctx context.Context) (store.TxDriver, error) {
+	if d.tx != nil {
+		return nil, errors.New("nested transactions are not supported")
+	}
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin transaction")
+	}
+	txDriver := *d
+	txDriver.conn = tx
+	txDriver.tx = tx
+	return &txDriver, nil
+}
+
+func (d *DB) Commit() error {
+	if d.tx == nil {
+		return errors.New("not in a transaction")
+	}
+	return d.tx.Commit()
+}
+
+func (d *DB) Rollback() error {
+	if d.tx == nil {
+		return errors.New("not in a transaction")
+	}
+	return d.tx.Rollback()
+}
+
 func mergeDSN(baseDSN string) (string, error) {
 	config, err := mysql.ParseDSN(baseDSN)
 	if err != nil {
@@ -2,6 +2,7 @@ package mysql
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -14,7 +15,7 @@ func (d *DB) UpsertReaction(ctx context.Context, upsert *store.Reaction) (*store
 	placeholder := []string{"?", "?", "?"}
 	args := []interface{}{upsert.CreatorID, upsert.ContentID, upsert.ReactionType}
 	stmt := "INSERT INTO `reaction` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -45,8 +46,16 @@ func (d *DB) ListReactions(ctx context.Context, find *store.FindReaction) ([]*st
 	if find.ContentID != nil {
 		where, args = append(where, "`content_id` = ?"), append(args, *find.ContentID)
 	}
+	if v := find.ContentIDList; len(v) != 0 {
+		holders := []string{}
+		for _, contentID := range v {
+			holders = append(holders, "?")
+			args = append(args, contentID)
+		}
+		where = append(where, fmt.Sprintf("`content_id` IN (%s)", strings.Join(holders, ", ")))
+	}
 
-	rows, err := d.db.QueryContext(ctx, `
+	rows, err := d.conn.QueryContext(ctx, `
 		SELECT
 			id,
 			UNIX_TIMESTAMP(created_ts) AS created_ts,
@@ -99,6 +108,6 @@ func (d *DB) GetReaction(ctx context.Context, find *store.FindReaction) (*store.
 }
 
 func (d *DB) DeleteReaction(ctx context.Context, delete *store.DeleteReaction) error {
-	_, err := d.db.ExecContext(ctx, "DELETE FROM `reaction` WHERE `id` = ?", delete.ID)
+	_, err := d.conn.ExecContext(ctx, "DELETE FROM `reaction` WHERE `id` = ?", delete.ID)
 	return err
 }
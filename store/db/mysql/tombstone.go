@@ -0,0 +1,81 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateTombstone(ctx context.Context, create *store.Tombstone) (*store.Tombstone, error) {
+	fields := []string{"`creator_id`", "`type`", "`uid`", "`reason`"}
+	placeholder := []string{"?", "?", "?", "?"}
+	args := []any{create.CreatorID, create.Type, create.UID, create.Reason}
+
+	stmt := "INSERT INTO `tombstone` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	rawID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	id := int32(rawID)
+	list, err := d.ListTombstones(ctx, &store.FindTombstone{ID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("failed to create tombstone")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListTombstones(ctx context.Context, find *store.FindTombstone) ([]*store.Tombstone, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *v)
+	}
+	if v := find.Type; v != nil {
+		where, args = append(where, "`type` = ?"), append(args, *v)
+	}
+	if v := find.CreatedTsAfter; v != nil {
+		// Compare the column itself so the index is used.
+		where, args = append(where, "`created_ts` > FROM_UNIXTIME(?)"), append(args, *v)
+	}
+
+	rows, err := d.conn.QueryContext(ctx, "SELECT `id`, `creator_id`, UNIX_TIMESTAMP(`created_ts`), `type`, `uid`, `reason` FROM `tombstone` WHERE "+strings.Join(where, " AND ")+" ORDER BY `created_ts` ASC, `id` ASC", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Tombstone{}
+	for rows.Next() {
+		tombstone := &store.Tombstone{}
+		if err := rows.Scan(
+			&tombstone.ID,
+			&tombstone.CreatorID,
+			&tombstone.CreatedTs,
+			&tombstone.Type,
+			&tombstone.UID,
+			&tombstone.Reason,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, tombstone)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/usememos/memos/plugin/filter"
 	"github.com/usememos/memos/store"
 )
 
@@ -16,7 +17,7 @@ func (d *DB) CreateUser(ctx context.Context, create *store.User) (*store.User, e
 	args := []any{create.Username, create.Role, create.Email, create.Nickname, create.PasswordHash, create.AvatarURL}
 
 	stmt := "INSERT INTO user (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -64,10 +65,22 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 	if v := update.Description; v != nil {
 		set, args = append(set, "`description` = ?"), append(args, *v)
 	}
+	if v := update.Location; v != nil {
+		set, args = append(set, "`location` = ?"), append(args, *v)
+	}
+	if v := update.Industry; v != nil {
+		set, args = append(set, "`industry` = ?"), append(args, *v)
+	}
+	if v := update.Occupation; v != nil {
+		set, args = append(set, "`occupation` = ?"), append(args, *v)
+	}
+	if v := update.University; v != nil {
+		set, args = append(set, "`university` = ?"), append(args, *v)
+	}
 	args = append(args, update.ID)
 
 	query := "UPDATE `user` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
-	if _, err := d.db.ExecContext(ctx, query, args...); err != nil {
+	if _, err := d.conn.ExecContext(ctx, query, args...); err != nil {
 		return nil, err
 	}
 
@@ -96,13 +109,33 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 	if v := find.Nickname; v != nil {
 		where, args = append(where, "`nickname` = ?"), append(args, *v)
 	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, "`row_status` = ?"), append(args, *v)
+	}
+	if v := find.Filter; v != nil {
+		parsedExpr, err := filter.Parse(*v, filter.UserFilterCELAttributes...)
+		if err != nil {
+			return nil, err
+		}
+		convertCtx := filter.NewConvertContext()
+		if err := d.ConvertUserExprToSQL(convertCtx, parsedExpr.GetExpr()); err != nil {
+			return nil, err
+		}
+		if condition := convertCtx.Buffer.String(); condition != "" {
+			where = append(where, fmt.Sprintf("(%s)", condition))
+			args = append(args, convertCtx.Args...)
+		}
+	}
 
 	orderBy := []string{"`created_ts` DESC", "`row_status` DESC"}
-	query := "SELECT `id`, `username`, `role`, `email`, `nickname`, `password_hash`, `avatar_url`, `description`, UNIX_TIMESTAMP(`created_ts`), UNIX_TIMESTAMP(`updated_ts`), `row_status` FROM `user` WHERE " + strings.Join(where, " AND ") + " ORDER BY " + strings.Join(orderBy, ", ")
+	query := "SELECT `id`, `username`, `role`, `email`, `nickname`, `password_hash`, `avatar_url`, `description`, `location`, `industry`, `occupation`, `university`, UNIX_TIMESTAMP(`created_ts`), UNIX_TIMESTAMP(`updated_ts`), `row_status` FROM `user` WHERE " + strings.Join(where, " AND ") + " ORDER BY " + strings.Join(orderBy, ", ")
 	if v := find.Limit; v != nil {
 		query += fmt.Sprintf(" LIMIT %d", *v)
+		if v := find.Offset; v != nil {
+			query += fmt.Sprintf(" OFFSET %d", *v)
+		}
 	}
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -120,6 +153,10 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 			&user.PasswordHash,
 			&user.AvatarURL,
 			&user.Description,
+			&user.Location,
+			&user.Industry,
+			&user.Occupation,
+			&user.University,
 			&user.CreatedTs,
 			&user.UpdatedTs,
 			&user.RowStatus,
@@ -148,7 +185,7 @@ func (d *DB) GetUser(ctx context.Context, find *store.FindUser) (*store.User, er
 }
 
 func (d *DB) DeleteUser(ctx context.Context, delete *store.DeleteUser) error {
-	result, err := d.db.ExecContext(ctx, "DELETE FROM `user` WHERE `id` = ?", delete.ID)
+	result, err := d.conn.ExecContext(ctx, "DELETE FROM `user` WHERE `id` = ?", delete.ID)
 	if err != nil {
 		return err
 	}
@@ -26,7 +26,7 @@ WHERE user_id = ? AND following_user_id = ?
 
 	// 执行查询操作
 	var count int
-	err := d.db.QueryRowContext(ctx, stmt, args...).Scan(&count)
+	err := d.conn.QueryRowContext(ctx, stmt, args...).Scan(&count)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			// 如果没有找到记录，这不是一个错误，只是表示未关注
@@ -40,36 +40,12 @@ WHERE user_id = ? AND following_user_id = ?
 }
 
 func (d *DB) FollowUser(ctx context.Context, follow *store.UserFollowing) error {
-	// 编写 SQL 插入语句
-	stmt := `
-INSERT INTO user_following (user_id, following_user_id, created_ts)
-VALUES (?, ?, UNIX_TIMESTAMP())
-`
-
-	// 准备参数
-	args := []interface{}{follow.UserID, follow.FollowingUserID}
-
-	// 执行插入操作
-	result, err := d.db.ExecContext(ctx, stmt, args...)
-	if err != nil {
-		// 检查是否是唯一约束冲突错误（即重复关注）
-		// if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1062 {
-		// 	// 1062 是 MySQL 中唯一约束冲突的错误码
-		// 	return store.ErrAlreadyFollowing // 假设你有一个自定义错误表示已关注
-		// }
-		return err // 其他错误，直接返回
-	}
-
-	// 检查是否真的有行被插入（可选，但有助于调试）
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
+	// Following the same user again is a no-op, so the retried request keeps the state.
+	stmt := "INSERT IGNORE INTO `user_following` (`user_id`, `following_user_id`) VALUES (?, ?)"
+	if _, err := d.conn.ExecContext(ctx, stmt, follow.UserID, follow.FollowingUserID); err != nil {
 		return err
 	}
-	if rowsAffected == 0 {
-		return fmt.Errorf("no rows affected by the insert operation")
-	}
-
-	return nil // 插入成功，返回 nil
+	return nil
 }
 
 func (d *DB) UnFollowUser(ctx context.Context, unFollow *store.UserFollowing) error {
@@ -83,7 +59,7 @@ DELETE FROM user_following WHERE user_id = ? AND following_user_id = ?
 	args := []interface{}{unFollow.UserID, unFollow.FollowingUserID}
 
 	// 执行删除操作
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return err
 	}
@@ -113,7 +89,7 @@ func (d *DB) GetFollowingList(ctx context.Context, userID int32) ([]*store.User,
     WHERE user_id = ?`
 
 	var followingUserIDs []int32
-	rows, err := d.db.QueryContext(ctx, followingQuery, userID)
+	rows, err := d.conn.QueryContext(ctx, followingQuery, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query user_following: %w", err)
 	}
@@ -145,7 +121,7 @@ func (d *DB) GetFollowingList(ctx context.Context, userID int32) ([]*store.User,
     WHERE id IN %s`, placeholders)
 
 	// Prepare the statement with the userQuery (optional but recommended for performance and security)
-	stmt, err := d.db.PrepareContext(ctx, userQuery)
+	stmt, err := d.conn.PrepareContext(ctx, userQuery)
 	if err != nil {
 		return nil, fmt.Errorf("failed to prepare user query: %w", err)
 	}
@@ -198,7 +174,7 @@ FROM user_following
 WHERE following_user_id = ?`
 
 	var followerUserIDs []int32
-	rows, err := d.db.QueryContext(ctx, followerQuery, userID)
+	rows, err := d.conn.QueryContext(ctx, followerQuery, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query user_following: %w", err)
 	}
@@ -230,7 +206,7 @@ FROM user
 WHERE id IN %s`, placeholders)
 
 	// Prepare the statement with the userQuery (optional but recommended for performance and security)
-	stmt, err := d.db.PrepareContext(ctx, userQuery)
+	stmt, err := d.conn.PrepareContext(ctx, userQuery)
 	if err != nil {
 		return nil, fmt.Errorf("failed to prepare user query: %w", err)
 	}
@@ -274,3 +250,60 @@ WHERE id IN %s`, placeholders)
 
 	return users, nil
 }
+
+func (d *DB) ListUserFollowings(ctx context.Context, find *store.FindUserFollowing) ([]*store.UserFollowing, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *v)
+	}
+	if v := find.FollowingUserID; v != nil {
+		where, args = append(where, "`following_user_id` = ?"), append(args, *v)
+	}
+	if v := find.Tag; v != nil {
+		where, args = append(where, "`tag` = ?"), append(args, *v)
+	}
+
+	rows, err := d.conn.QueryContext(ctx, "SELECT `id`, `user_id`, `following_user_id`, `tag`, UNIX_TIMESTAMP(`created_ts`) FROM `user_following` WHERE "+strings.Join(where, " AND ")+" ORDER BY `tag`, `created_ts`, `id`", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.UserFollowing{}
+	for rows.Next() {
+		userFollowing := &store.UserFollowing{}
+		if err := rows.Scan(
+			&userFollowing.ID,
+			&userFollowing.UserID,
+			&userFollowing.FollowingUserID,
+			&userFollowing.Tag,
+			&userFollowing.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, userFollowing)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateUserFollowing(ctx context.Context, update *store.UpdateUserFollowing) error {
+	set, args := []string{}, []any{}
+	if v := update.Tag; v != nil {
+		set, args = append(set, "`tag` = ?"), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+
+	stmt := "UPDATE `user_following` SET " + strings.Join(set, ", ") + " WHERE `user_id` = ? AND `following_user_id` = ?"
+	args = append(args, update.UserID, update.FollowingUserID)
+	if _, err := d.conn.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateUserGroup(ctx context.Context, create *store.UserGroup) (*store.UserGroup, error) {
+	stmt := "INSERT INTO `user_group` (`creator_id`, `name`) VALUES (?, ?)"
+	result, err := d.conn.ExecContext(ctx, stmt, create.CreatorID, create.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	id32 := int32(id)
+	list, err := d.ListUserGroups(ctx, &store.FindUserGroup{ID: &id32})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("failed to create user group")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListUserGroups(ctx context.Context, find *store.FindUserGroup) ([]*store.UserGroup, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := find.MemberID; v != nil {
+		where, args = append(where, "`id` IN (SELECT `group_id` FROM `user_group_member` WHERE `user_id` = ?)"), append(args, *v)
+	}
+
+	rows, err := d.conn.QueryContext(ctx, "SELECT `id`, `creator_id`, UNIX_TIMESTAMP(`created_ts`), `name` FROM `user_group` WHERE "+strings.Join(where, " AND ")+" ORDER BY `name`, `id`", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.UserGroup{}
+	for rows.Next() {
+		userGroup := &store.UserGroup{}
+		if err := rows.Scan(
+			&userGroup.ID,
+			&userGroup.CreatorID,
+			&userGroup.CreatedTs,
+			&userGroup.Name,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, userGroup)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateUserGroup(ctx context.Context, update *store.UpdateUserGroup) (*store.UserGroup, error) {
+	set, args := []string{}, []any{}
+	if v := update.Name; v != nil {
+		set, args = append(set, "`name` = ?"), append(args, *v)
+	}
+	args = append(args, update.ID)
+
+	stmt := "UPDATE `user_group` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
+	if _, err := d.conn.ExecContext(ctx, stmt, args...); err != nil {
+		return nil, err
+	}
+	list, err := d.ListUserGroups(ctx, &store.FindUserGroup{ID: &update.ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("failed to update user group")
+	}
+	return list[0], nil
+}
+
+func (d *DB) DeleteUserGroup(ctx context.Context, delete *store.DeleteUserGroup) error {
+	if _, err := d.conn.ExecContext(ctx, "DELETE FROM `user_group` WHERE `id` = ?", delete.ID); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) CreateUserGroupMember(ctx context.Context, create *store.UserGroupMember) error {
+	stmt := "INSERT IGNORE INTO `user_group_member` (`group_id`, `user_id`) VALUES (?, ?)"
+	if _, err := d.conn.ExecContext(ctx, stmt, create.GroupID, create.UserID); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) ListUserGroupMembers(ctx context.Context, find *store.FindUserGroupMember) ([]*store.UserGroupMember, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.GroupID; v != nil {
+		where, args = append(where, "`group_id` = ?"), append(args, *v)
+	}
+	if v := find.GroupIDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders = append(holders, "?")
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("`group_id` IN (%s)", strings.Join(holders, ", ")))
+	}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *v)
+	}
+
+	rows, err := d.conn.QueryContext(ctx, "SELECT `group_id`, `user_id`, UNIX_TIMESTAMP(`created_ts`) FROM `user_group_member` WHERE "+strings.Join(where, " AND ")+" ORDER BY `group_id`, `user_id`", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.UserGroupMember{}
+	for rows.Next() {
+		member := &store.UserGroupMember{}
+		if err := rows.Scan(&member.GroupID, &member.UserID, &member.CreatedTs); err != nil {
+			return nil, err
+		}
+		list = append(list, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteUserGroupMember(ctx context.Context, delete *store.DeleteUserGroupMember) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := delete.GroupID; v != nil {
+		where, args = append(where, "`group_id` = ?"), append(args, *v)
+	}
+	if v := delete.UserID; v != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *v)
+	}
+	if _, err := d.conn.ExecContext(ctx, "DELETE FROM `user_group_member` WHERE "+strings.Join(where, " AND "), args...); err != nil {
+		return err
+	}
+	return nil
+}
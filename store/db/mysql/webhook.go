@@ -13,7 +13,7 @@ func (d *DB) CreateWebhook(ctx context.Context, create *store.Webhook) (*store.W
 	args := []any{create.Name, create.URL, create.CreatorID}
 
 	stmt := "INSERT INTO `webhook` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +36,7 @@ func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*stor
 		where, args = append(where, "`creator_id` = ?"), append(args, *find.CreatorID)
 	}
 
-	rows, err := d.db.QueryContext(ctx, "SELECT `id`, UNIX_TIMESTAMP(`created_ts`), UNIX_TIMESTAMP(`updated_ts`),  `creator_id`, `name`, `url` FROM `webhook` WHERE "+strings.Join(where, " AND ")+" ORDER BY `id` DESC",
+	rows, err := d.conn.QueryContext(ctx, "SELECT `id`, UNIX_TIMESTAMP(`created_ts`), UNIX_TIMESTAMP(`updated_ts`),  `creator_id`, `name`, `url` FROM `webhook` WHERE "+strings.Join(where, " AND ")+" ORDER BY `id` DESC",
 		args...,
 	)
 	if err != nil {
@@ -89,7 +89,7 @@ func (d *DB) UpdateWebhook(ctx context.Context, update *store.UpdateWebhook) (*s
 	args = append(args, update.ID)
 
 	stmt := "UPDATE `webhook` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
-	_, err := d.db.ExecContext(ctx, stmt, args...)
+	_, err := d.conn.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -103,6 +103,6 @@ func (d *DB) UpdateWebhook(ctx context.Context, update *store.UpdateWebhook) (*s
 }
 
 func (d *DB) DeleteWebhook(ctx context.Context, delete *store.DeleteWebhook) error {
-	_, err := d.db.ExecContext(ctx, "DELETE FROM `webhook` WHERE `id` = ?", delete.ID)
+	_, err := d.conn.ExecContext(ctx, "DELETE FROM `webhook` WHERE `id` = ?", delete.ID)
 	return err
 }
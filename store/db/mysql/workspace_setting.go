@@ -9,7 +9,7 @@ import (
 
 func (d *DB) UpsertWorkspaceSetting(ctx context.Context, upsert *store.WorkspaceSetting) (*store.WorkspaceSetting, error) {
 	stmt := "INSERT INTO `system_setting` (`name`, `value`, `description`) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE `value` = ?, `description` = ?"
-	_, err := d.db.ExecContext(
+	_, err := d.conn.ExecContext(
 		ctx,
 		stmt,
 		upsert.Name,
@@ -32,7 +32,7 @@ func (d *DB) ListWorkspaceSettings(ctx context.Context, find *store.FindWorkspac
 	}
 
 	query := "SELECT `name`, `value`, `description` FROM `system_setting` WHERE " + strings.Join(where, " AND ")
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -60,6 +60,6 @@ func (d *DB) ListWorkspaceSettings(ctx context.Context, find *store.FindWorkspac
 
 func (d *DB) DeleteWorkspaceSetting(ctx context.Context, delete *store.DeleteWorkspaceSetting) error {
 	stmt := "DELETE FROM `system_setting` WHERE `name` = ?"
-	_, err := d.db.ExecContext(ctx, stmt, delete.Name)
+	_, err := d.conn.ExecContext(ctx, stmt, delete.Name)
 	return err
 }
@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateFollowRequest(ctx context.Context, create *store.FollowRequest) (*store.FollowRequest, error) {
+	fields := []string{"requester_id", "user_id"}
+	args := []any{create.RequesterID, create.UserID}
+
+	stmt := "INSERT INTO follow_request (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts"
+	if err := d.conn.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListFollowRequests(ctx context.Context, find *store.FindFollowRequest) ([]*store.FollowRequest, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.RequesterID; v != nil {
+		where, args = append(where, "requester_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	rows, err := d.conn.QueryContext(ctx, `
+		SELECT
+			id,
+			created_ts,
+			requester_id,
+			user_id
+		FROM follow_request
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY created_ts DESC, id DESC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.FollowRequest{}
+	for rows.Next() {
+		followRequest := &store.FollowRequest{}
+		if err := rows.Scan(
+			&followRequest.ID,
+			&followRequest.CreatedTs,
+			&followRequest.RequesterID,
+			&followRequest.UserID,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, followRequest)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteFollowRequest(ctx context.Context, delete *store.DeleteFollowRequest) error {
+	if _, err := d.conn.ExecContext(ctx, `DELETE FROM follow_request WHERE id = `+placeholder(1), delete.ID); err != nil {
+		return err
+	}
+	return nil
+}
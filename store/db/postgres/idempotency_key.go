@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateIdempotencyKey(ctx context.Context, create *store.IdempotencyKey) (*store.IdempotencyKey, error) {
+	fields := []string{"creator_id", "key", "method", "name"}
+	args := []any{create.CreatorID, create.Key, create.Method, create.Name}
+
+	stmt := "INSERT INTO idempotency_key (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts"
+	if err := d.conn.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListIdempotencyKeys(ctx context.Context, find *store.FindIdempotencyKey) ([]*store.IdempotencyKey, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "creator_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.Key; v != nil {
+		where, args = append(where, "key = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.Method; v != nil {
+		where, args = append(where, "method = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	rows, err := d.conn.QueryContext(ctx, `
+		SELECT
+			id,
+			creator_id,
+			created_ts,
+			key,
+			method,
+			name
+		FROM idempotency_key
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY id DESC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.IdempotencyKey{}
+	for rows.Next() {
+		idempotencyKey := &store.IdempotencyKey{}
+		if err := rows.Scan(
+			&idempotencyKey.ID,
+			&idempotencyKey.CreatorID,
+			&idempotencyKey.CreatedTs,
+			&idempotencyKey.Key,
+			&idempotencyKey.Method,
+			&idempotencyKey.Name,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, idempotencyKey)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateIdempotencyKey(ctx context.Context, update *store.UpdateIdempotencyKey) error {
+	set, args := []string{}, []any{}
+	if v := update.Name; v != nil {
+		set, args = append(set, "name = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+
+	stmt := `UPDATE idempotency_key SET ` + strings.Join(set, ", ") + ` WHERE id = ` + placeholder(len(args)+1)
+	args = append(args, update.ID)
+	if _, err := d.conn.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) DeleteIdempotencyKey(ctx context.Context, delete *store.DeleteIdempotencyKey) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := delete.ID; v != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := delete.CreatedTsBefore; v != nil {
+		where, args = append(where, "created_ts < "+placeholder(len(args)+1)), append(args, *v)
+	}
+	stmt := `DELETE FROM idempotency_key WHERE ` + strings.Join(where, " AND ")
+	if _, err := d.conn.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
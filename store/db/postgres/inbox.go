@@ -25,7 +25,7 @@ func (d *DB) CreateInbox(ctx context.Context, create *store.Inbox) (*store.Inbox
 	fields := []string{"sender_id", "receiver_id", "status", "message"}
 	args := []any{create.SenderID, create.ReceiverID, create.Status, messageString}
 	stmt := "INSERT INTO inbox (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	if err := d.conn.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.CreatedTs,
 	); err != nil {
@@ -50,15 +50,19 @@ func (d *DB) ListInboxes(ctx context.Context, find *store.FindInbox) ([]*store.I
 	if find.Status != nil {
 		where, args = append(where, "status = "+placeholder(len(args)+1)), append(args, *find.Status)
 	}
+	if v := find.Cursor; v != nil {
+		where = append(where, fmt.Sprintf("(created_ts < %s OR (created_ts = %s AND id < %s))", placeholder(len(args)+1), placeholder(len(args)+2), placeholder(len(args)+3)))
+		args = append(args, v.Ts, v.Ts, v.ID)
+	}
 
-	query := "SELECT id, created_ts, sender_id, receiver_id, status, message FROM inbox WHERE " + strings.Join(where, " AND ") + " ORDER BY created_ts DESC"
+	query := "SELECT id, created_ts, sender_id, receiver_id, status, message FROM inbox WHERE " + strings.Join(where, " AND ") + " ORDER BY created_ts DESC, id DESC"
 	if find.Limit != nil {
 		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
 		if find.Offset != nil {
 			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
 		}
 	}
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -111,7 +115,7 @@ func (d *DB) UpdateInbox(ctx context.Context, update *store.UpdateInbox) (*store
 	query := "UPDATE inbox SET " + strings.Join(set, ", ") + " WHERE id = $2 RETURNING id, created_ts, sender_id, receiver_id, status, message"
 	inbox := &store.Inbox{}
 	var messageBytes []byte
-	if err := d.db.QueryRowContext(ctx, query, args...).Scan(
+	if err := d.conn.QueryRowContext(ctx, query, args...).Scan(
 		&inbox.ID,
 		&inbox.CreatedTs,
 		&inbox.SenderID,
@@ -130,7 +134,7 @@ func (d *DB) UpdateInbox(ctx context.Context, update *store.UpdateInbox) (*store
 }
 
 func (d *DB) DeleteInbox(ctx context.Context, delete *store.DeleteInbox) error {
-	result, err := d.db.ExecContext(ctx, "DELETE FROM inbox WHERE id = $1", delete.ID)
+	result, err := d.conn.ExecContext(ctx, "DELETE FROM inbox WHERE id = $1", delete.ID)
 	if err != nil {
 		return err
 	}
@@ -4,17 +4,19 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/usememos/memos/plugin/filter"
+	"github.com/usememos/memos/plugin/search"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
 func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, error) {
-	fields := []string{"uid", "creator_id", "content", "visibility", "payload"}
+	fields := []string{"uid", "creator_id", "content", "visibility", "payload", "changed_ts"}
 	payload := "{}"
 	if create.Payload != nil {
 		payloadBytes, err := protojson.Marshal(create.Payload)
@@ -23,10 +25,11 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 		}
 		payload = string(payloadBytes)
 	}
-	args := []any{create.UID, create.CreatorID, create.Content, create.Visibility, payload}
+	create.ChangedTs = time.Now().Unix()
+	args := []any{create.UID, create.CreatorID, create.Content, create.Visibility, payload, create.ChangedTs}
 
 	stmt := "INSERT INTO memo (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts, row_status"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	if err := d.conn.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.CreatedTs,
 		&create.UpdatedTs,
@@ -44,19 +47,34 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.ID; v != nil {
 		where, args = append(where, "memo.id = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := find.IDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("memo.id IN (%s)", strings.Join(holders, ", ")))
+	}
 	if v := find.UID; v != nil {
 		where, args = append(where, "memo.uid = "+placeholder(len(args)+1)), append(args, *v)
 	}
 	if v := find.CreatorID; v != nil {
 		if find.IsFollow {
-			// 使用PostgreSQL的ARRAY构造函数和ANY操作符来检查creator_id是否在子查询结果中
-			where = append(where, "`memo`.`creator_id` = ANY(ARRAY(SELECT `following_user_id` FROM `user_following` WHERE `user_id` = "+placeholder(len(args)+1)+"))")
-			args = append(args, *v)
+			if tag := find.FollowTag; tag != nil {
+				where = append(where, "memo.creator_id IN (SELECT following_user_id FROM user_following WHERE user_id = "+placeholder(len(args)+1)+" AND tag = "+placeholder(len(args)+2)+")")
+				args = append(args, *v, *tag)
+			} else {
+				where = append(where, "memo.creator_id IN (SELECT following_user_id FROM user_following WHERE user_id = "+placeholder(len(args)+1)+")")
+				args = append(args, *v)
+			}
 		} else {
-			// 简单的等值比较在PostgreSQL中同样适用
-			where, args = append(where, "`memo`.`creator_id` = "+placeholder(len(args)+1)), append(args, *v)
+			where, args = append(where, "memo.creator_id = "+placeholder(len(args)+1)), append(args, *v)
 		}
 	}
+	if v := find.MuterID; v != nil {
+		where = append(where, "memo.creator_id NOT IN (SELECT blocked_user_id FROM user_block WHERE user_id = "+placeholder(len(args)+1)+" AND type = "+placeholder(len(args)+2)+")")
+		args = append(args, *v, store.UserBlockTypeMute.String())
+	}
 	if v := find.RowStatus; v != nil {
 		where, args = append(where, "memo.row_status = "+placeholder(len(args)+1)), append(args, *v)
 	}
@@ -72,9 +90,17 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.UpdatedTsAfter; v != nil {
 		where, args = append(where, "memo.updated_ts > "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := find.ChangedTsAfter; v != nil {
+		where, args = append(where, "memo.changed_ts > "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.TrashedTsBefore; v != nil {
+		where, args = append(where, "memo.trashed_ts < "+placeholder(len(args)+1)), append(args, *v)
+	}
 	if v := find.ContentSearch; len(v) != 0 {
 		for _, s := range v {
-			where, args = append(where, "memo.content ILIKE "+placeholder(len(args)+1)), append(args, fmt.Sprintf("%%%s%%", s))
+			for _, token := range search.Tokenize(s) {
+				where, args = append(where, "memo.content ILIKE "+placeholder(len(args)+1)), append(args, fmt.Sprintf("%%%s%%", token))
+			}
 		}
 	}
 	if v := find.VisibilityList; len(v) != 0 {
@@ -83,7 +109,20 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			holders = append(holders, placeholder(len(args)+1))
 			args = append(args, visibility.String())
 		}
-		where = append(where, fmt.Sprintf("memo.visibility in (%s)", strings.Join(holders, ", ")))
+		condition := fmt.Sprintf("memo.visibility in (%s)", strings.Join(holders, ", "))
+		if principals := find.ACLPrincipals; len(principals) != 0 {
+			principalHolders := []string{}
+			for _, principal := range principals {
+				principalHolders = append(principalHolders, placeholder(len(args)+1))
+				args = append(args, principal)
+			}
+			condition = fmt.Sprintf("(%s OR memo.id IN (SELECT memo_id FROM memo_acl WHERE principal IN (%s)))", condition, strings.Join(principalHolders, ", "))
+		}
+		if v := find.FollowerID; v != nil {
+			condition = fmt.Sprintf("(%s OR (memo.visibility = %s AND memo.creator_id IN (SELECT following_user_id FROM user_following WHERE user_id = %s)))", condition, placeholder(len(args)+1), placeholder(len(args)+2))
+			args = append(args, store.Followers.String(), *v)
+		}
+		where = append(where, condition)
 	}
 	if v := find.PayloadFind; v != nil {
 		if v.Raw != nil {
@@ -91,7 +130,8 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		}
 		if len(v.TagSearch) != 0 {
 			for _, tag := range v.TagSearch {
-				where, args = append(where, "EXISTS (SELECT 1 FROM jsonb_array_elements(memo.payload->'tags') AS tag WHERE tag::text = "+placeholder(len(args)+1)+" OR tag::text LIKE "+placeholder(len(args)+2)+")"), append(args, fmt.Sprintf(`"%s"`, tag), fmt.Sprintf(`"%s/%%"`, tag))
+				// Match the tag and its sub tags.
+				where, args = append(where, "memo.id IN (SELECT memo_id FROM memo_tag WHERE tag = "+placeholder(len(args)+1)+" OR tag LIKE "+placeholder(len(args)+2)+")"), append(args, tag, escapeLikePattern(tag)+"/%")
 			}
 		}
 		if v.HasLink {
@@ -129,6 +169,34 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.ExcludeComments {
 		where = append(where, "memo_relation.related_memo_id IS NULL")
 	}
+	if find.ExcludeTrashedWithParent {
+		where = append(where, "NOT EXISTS (SELECT 1 FROM memo AS parent WHERE parent.id = memo_relation.related_memo_id AND parent.row_status = 'TRASHED' AND parent.trashed_ts = memo.trashed_ts)")
+	}
+	if v := find.Cursor; v != nil {
+		operator := "<"
+		if find.OrderByTimeAsc {
+			operator = ">"
+		}
+		tsField := "memo.created_ts"
+		if find.OrderByChangedTs {
+			tsField = "memo.changed_ts"
+		} else if find.OrderByUpdatedTs {
+			tsField = "memo.updated_ts"
+		}
+		if find.OrderByPinned {
+			// Pinned memos always come first.
+			where = append(where, fmt.Sprintf("(memo.pinned < %s OR (memo.pinned = %s AND (%s %s %s OR (%s = %s AND memo.id %s %s))))",
+				placeholder(len(args)+1), placeholder(len(args)+2),
+				tsField, operator, placeholder(len(args)+3),
+				tsField, placeholder(len(args)+4), operator, placeholder(len(args)+5)))
+			args = append(args, v.Pinned, v.Pinned, v.Ts, v.Ts, v.ID)
+		} else {
+			where = append(where, fmt.Sprintf("(%s %s %s OR (%s = %s AND memo.id %s %s))",
+				tsField, operator, placeholder(len(args)+1),
+				tsField, placeholder(len(args)+2), operator, placeholder(len(args)+3)))
+			args = append(args, v.Ts, v.Ts, v.ID)
+		}
+	}
 
 	orders := []string{}
 	if find.OrderByPinned {
@@ -138,7 +206,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.OrderByTimeAsc {
 		order = "ASC"
 	}
-	if find.OrderByUpdatedTs {
+	if find.OrderByChangedTs {
+		orders = append(orders, "changed_ts "+order)
+	} else if find.OrderByUpdatedTs {
 		orders = append(orders, "updated_ts "+order)
 	} else {
 		orders = append(orders, "created_ts "+order)
@@ -150,10 +220,13 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		`memo.creator_id AS creator_id`,
 		`memo.created_ts AS created_ts`,
 		`memo.updated_ts AS updated_ts`,
+		`memo.trashed_ts AS trashed_ts`,
 		`memo.row_status AS row_status`,
 		`memo.visibility AS visibility`,
 		`memo.pinned AS pinned`,
 		`memo.payload AS payload`,
+		`memo.version AS version`,
+		`memo.changed_ts AS changed_ts`,
 		`memo_relation.related_memo_id AS parent_id`,
 	}
 	if !find.ExcludeContent {
@@ -172,7 +245,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		}
 	}
 
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -188,10 +261,13 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			&memo.CreatorID,
 			&memo.CreatedTs,
 			&memo.UpdatedTs,
+			&memo.TrashedTs,
 			&memo.RowStatus,
 			&memo.Visibility,
 			&memo.Pinned,
 			&payloadBytes,
+			&memo.Version,
+			&memo.ChangedTs,
 			&memo.ParentID,
 		}
 		if !find.ExcludeContent {
@@ -239,6 +315,9 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	if v := update.UpdatedTs; v != nil {
 		set, args = append(set, "updated_ts = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := update.TrashedTs; v != nil {
+		set, args = append(set, "trashed_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
 	if v := update.RowStatus; v != nil {
 		set, args = append(set, "row_status = "+placeholder(len(args)+1)), append(args, *v)
 	}
@@ -261,19 +340,34 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	if len(set) == 0 {
 		return nil
 	}
+	set = append(set, "version = version + 1", "changed_ts = "+placeholder(len(args)+1))
+	args = append(args, time.Now().Unix())
+	where, args := []string{"id = " + placeholder(len(args)+1)}, append(args, update.ID)
+	if v := update.Version; v != nil {
+		where, args = append(where, "version = "+placeholder(len(args)+1)), append(args, *v)
+	}
 
-	stmt := `UPDATE memo SET ` + strings.Join(set, ", ") + ` WHERE id = ` + placeholder(len(args)+1)
-	args = append(args, update.ID)
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+	stmt := `UPDATE memo SET ` + strings.Join(set, ", ") + ` WHERE ` + strings.Join(where, " AND ")
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
+	if err != nil {
 		return err
 	}
+	if update.Version != nil {
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return store.ErrMemoVersionMismatch
+		}
+	}
 	return nil
 }
 
 func (d *DB) DeleteMemo(ctx context.Context, delete *store.DeleteMemo) error {
 	where, args := []string{"id = " + placeholder(1)}, []any{delete.ID}
 	stmt := `DELETE FROM memo WHERE ` + strings.Join(where, " AND ")
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return errors.Wrap(err, "failed to delete memo")
 	}
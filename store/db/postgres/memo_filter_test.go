@@ -16,17 +16,17 @@ func TestRestoreExprToSQL(t *testing.T) {
 	}{
 		{
 			filter: `tag in ["tag1", "tag2"]`,
-			want:   "(memo.payload->'tags' @> $1::jsonb OR memo.payload->'tags' @> $2::jsonb)",
-			args:   []any{[]any{"tag1"}, []any{"tag2"}},
+			want:   "memo.id IN (SELECT memo_id FROM memo_tag WHERE tag IN ($1, $2))",
+			args:   []any{"tag1", "tag2"},
 		},
 		{
 			filter: `!(tag in ["tag1", "tag2"])`,
-			want:   `NOT ((memo.payload->'tags' @> $1::jsonb OR memo.payload->'tags' @> $2::jsonb))`,
-			args:   []any{[]any{"tag1"}, []any{"tag2"}},
+			want:   "NOT (memo.id IN (SELECT memo_id FROM memo_tag WHERE tag IN ($1, $2)))",
+			args:   []any{"tag1", "tag2"},
 		},
 		{
 			filter: `content.contains("memos")`,
-			want:   "memo.content ILIKE LIKE $1",
+			want:   "memo.content ILIKE $1",
 			args:   []any{"%memos%"},
 		},
 		{
@@ -46,8 +46,8 @@ func TestRestoreExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `tag in ['tag1'] || content.contains('hello')`,
-			want:   "(memo.payload->'tags' @> $1::jsonb OR memo.content ILIKE LIKE $2)",
-			args:   []any{[]any{"tag1"}, "%hello%"},
+			want:   "(memo.id IN (SELECT memo_id FROM memo_tag WHERE tag IN ($1)) OR memo.content ILIKE $2)",
+			args:   []any{"tag1", "%hello%"},
 		},
 	}
 
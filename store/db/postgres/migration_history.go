@@ -8,7 +8,7 @@ import (
 
 func (d *DB) FindMigrationHistoryList(ctx context.Context, _ *store.FindMigrationHistory) ([]*store.MigrationHistory, error) {
 	query := "SELECT version, created_ts FROM migration_history ORDER BY created_ts DESC"
-	rows, err := d.db.QueryContext(ctx, query)
+	rows, err := d.conn.QueryContext(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -46,7 +46,7 @@ func (d *DB) UpsertMigrationHistory(ctx context.Context, upsert *store.UpsertMig
 		RETURNING version, created_ts
 	`
 	var migrationHistory store.MigrationHistory
-	if err := d.db.QueryRowContext(ctx, stmt, upsert.Version).Scan(
+	if err := d.conn.QueryRowContext(ctx, stmt, upsert.Version).Scan(
 		&migrationHistory.Version,
 		&migrationHistory.CreatedTs,
 	); err != nil {
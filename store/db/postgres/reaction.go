@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/usememos/memos/store"
@@ -11,7 +12,7 @@ func (d *DB) UpsertReaction(ctx context.Context, upsert *store.Reaction) (*store
 	fields := []string{"creator_id", "content_id", "reaction_type"}
 	args := []interface{}{upsert.CreatorID, upsert.ContentID, upsert.ReactionType}
 	stmt := "INSERT INTO reaction (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	if err := d.conn.QueryRowContext(ctx, stmt, args...).Scan(
 		&upsert.ID,
 		&upsert.CreatedTs,
 	); err != nil {
@@ -33,8 +34,16 @@ func (d *DB) ListReactions(ctx context.Context, find *store.FindReaction) ([]*st
 	if find.ContentID != nil {
 		where, args = append(where, "content_id = "+placeholder(len(args)+1)), append(args, *find.ContentID)
 	}
+	if v := find.ContentIDList; len(v) != 0 {
+		holders := []string{}
+		for _, contentID := range v {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, contentID)
+		}
+		where = append(where, fmt.Sprintf("content_id IN (%s)", strings.Join(holders, ", ")))
+	}
 
-	rows, err := d.db.QueryContext(ctx, `
+	rows, err := d.conn.QueryContext(ctx, `
 		SELECT
 			id,
 			created_ts,
@@ -74,6 +83,6 @@ func (d *DB) ListReactions(ctx context.Context, find *store.FindReaction) ([]*st
 }
 
 func (d *DB) DeleteReaction(ctx context.Context, delete *store.DeleteReaction) error {
-	_, err := d.db.ExecContext(ctx, "DELETE FROM reaction WHERE id = $1", delete.ID)
+	_, err := d.conn.ExecContext(ctx, "DELETE FROM reaction WHERE id = $1", delete.ID)
 	return err
 }
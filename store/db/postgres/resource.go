@@ -30,7 +30,7 @@ func (d *DB) CreateResource(ctx context.Context, create *store.Resource) (*store
 	args := []any{create.UID, create.Filename, create.Blob, create.Type, create.Size, create.CreatorID, create.MemoID, storageType, create.Reference, payloadString}
 
 	stmt := "INSERT INTO resource (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(&create.ID, &create.CreatedTs, &create.UpdatedTs); err != nil {
+	if err := d.conn.QueryRowContext(ctx, stmt, args...).Scan(&create.ID, &create.CreatedTs, &create.UpdatedTs); err != nil {
 		return nil, err
 	}
 	return create, nil
@@ -57,14 +57,25 @@ func (d *DB) ListResources(ctx context.Context, find *store.FindResource) ([]*st
 	if v := find.MemoID; v != nil {
 		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := find.MemoIDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("memo_id IN (%s)", strings.Join(holders, ", ")))
+	}
 	if find.HasRelatedMemo {
 		where = append(where, "memo_id IS NOT NULL")
 	}
 	if v := find.StorageType; v != nil {
 		where, args = append(where, "storage_type = "+placeholder(len(args)+1)), append(args, v.String())
 	}
+	if v := find.UpdatedTsAfter; v != nil {
+		where, args = append(where, "updated_ts > "+placeholder(len(args)+1)), append(args, *v)
+	}
 
-	fields := []string{"id", "uid", "filename", "type", "size", "creator_id", "created_ts", "updated_ts", "memo_id", "storage_type", "reference", "payload"}
+	fields := []string{"id", "uid", "filename", "type", "size", "creator_id", "created_ts", "updated_ts", "memo_id", "storage_type", "reference", "payload", "version"}
 	if find.GetBlob {
 		fields = append(fields, "blob")
 	}
@@ -83,7 +94,7 @@ func (d *DB) ListResources(ctx context.Context, find *store.FindResource) ([]*st
 		}
 	}
 
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -108,6 +119,7 @@ func (d *DB) ListResources(ctx context.Context, find *store.FindResource) ([]*st
 			&storageType,
 			&resource.Reference,
 			&payloadBytes,
+			&resource.Version,
 		}
 		if find.GetBlob {
 			dests = append(dests, &resource.Blob)
@@ -161,21 +173,32 @@ func (d *DB) UpdateResource(ctx context.Context, update *store.UpdateResource) e
 		set, args = append(set, "payload = "+placeholder(len(args)+1)), append(args, string(bytes))
 	}
 
-	stmt := `UPDATE resource SET ` + strings.Join(set, ", ") + ` WHERE id = ` + placeholder(len(args)+1)
-	args = append(args, update.ID)
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	set = append(set, "version = version + 1")
+
+	where, args := []string{"id = " + placeholder(len(args)+1)}, append(args, update.ID)
+	if v := update.Version; v != nil {
+		where, args = append(where, "version = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	stmt := `UPDATE resource SET ` + strings.Join(set, ", ") + ` WHERE ` + strings.Join(where, " AND ")
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return err
 	}
-	if _, err := result.RowsAffected(); err != nil {
-		return err
+	if update.Version != nil {
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return store.ErrResourceVersionMismatch
+		}
 	}
 	return nil
 }
 
 func (d *DB) DeleteResource(ctx context.Context, delete *store.DeleteResource) error {
 	stmt := `DELETE FROM resource WHERE id = $1`
-	result, err := d.db.ExecContext(ctx, stmt, delete.ID)
+	result, err := d.conn.ExecContext(ctx, stmt, delete.ID)
 	if err != nil {
 		return err
 	}
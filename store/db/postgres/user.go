@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/usememos/memos/plugin/filter"
 	"github.com/usememos/memos/store"
 )
 
@@ -12,7 +13,7 @@ func (d *DB) CreateUser(ctx context.Context, create *store.User) (*store.User, e
 	fields := []string{"username", "role", "email", "nickname", "password_hash", "avatar_url"}
 	args := []any{create.Username, create.Role, create.Email, create.Nickname, create.PasswordHash, create.AvatarURL}
 	stmt := "INSERT INTO \"user\" (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, avatar_url, description, created_ts, updated_ts, row_status"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	if err := d.conn.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.AvatarURL,
 		&create.Description,
@@ -52,16 +53,28 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 	if v := update.Description; v != nil {
 		set, args = append(set, "description = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := update.Location; v != nil {
+		set, args = append(set, "location = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Industry; v != nil {
+		set, args = append(set, "industry = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Occupation; v != nil {
+		set, args = append(set, "occupation = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.University; v != nil {
+		set, args = append(set, "university = "+placeholder(len(args)+1)), append(args, *v)
+	}
 
 	query := `
 		UPDATE "user"
 		SET ` + strings.Join(set, ", ") + `
 		WHERE id = ` + placeholder(len(args)+1) + `
-		RETURNING id, username, role, email, nickname, password_hash, avatar_url, description, created_ts, updated_ts, row_status
+		RETURNING id, username, role, email, nickname, password_hash, avatar_url, description, location, industry, occupation, university, created_ts, updated_ts, row_status
 	`
 	args = append(args, update.ID)
 	user := &store.User{}
-	if err := d.db.QueryRowContext(ctx, query, args...).Scan(
+	if err := d.conn.QueryRowContext(ctx, query, args...).Scan(
 		&user.ID,
 		&user.Username,
 		&user.Role,
@@ -70,6 +83,10 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 		&user.PasswordHash,
 		&user.AvatarURL,
 		&user.Description,
+		&user.Location,
+		&user.Industry,
+		&user.Occupation,
+		&user.University,
 		&user.CreatedTs,
 		&user.UpdatedTs,
 		&user.RowStatus,
@@ -98,6 +115,24 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 	if v := find.Nickname; v != nil {
 		where, args = append(where, "nickname = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, "row_status = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.Filter; v != nil {
+		parsedExpr, err := filter.Parse(*v, filter.UserFilterCELAttributes...)
+		if err != nil {
+			return nil, err
+		}
+		convertCtx := filter.NewConvertContext()
+		convertCtx.ArgsOffset = len(args)
+		if err := d.ConvertUserExprToSQL(convertCtx, parsedExpr.GetExpr()); err != nil {
+			return nil, err
+		}
+		if condition := convertCtx.Buffer.String(); condition != "" {
+			where = append(where, fmt.Sprintf("(%s)", condition))
+			args = append(args, convertCtx.Args...)
+		}
+	}
 
 	orderBy := []string{"created_ts DESC", "row_status DESC"}
 	query := `
@@ -110,6 +145,10 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 			password_hash,
 			avatar_url,
 			description,
+			location,
+			industry,
+			occupation,
+			university,
 			created_ts,
 			updated_ts,
 			row_status
@@ -117,8 +156,11 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 		WHERE ` + strings.Join(where, " AND ") + ` ORDER BY ` + strings.Join(orderBy, ", ")
 	if v := find.Limit; v != nil {
 		query += fmt.Sprintf(" LIMIT %d", *v)
+		if v := find.Offset; v != nil {
+			query += fmt.Sprintf(" OFFSET %d", *v)
+		}
 	}
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -136,6 +178,10 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 			&user.PasswordHash,
 			&user.AvatarURL,
 			&user.Description,
+			&user.Location,
+			&user.Industry,
+			&user.Occupation,
+			&user.University,
 			&user.CreatedTs,
 			&user.UpdatedTs,
 			&user.RowStatus,
@@ -153,7 +199,7 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 }
 
 func (d *DB) DeleteUser(ctx context.Context, delete *store.DeleteUser) error {
-	result, err := d.db.ExecContext(ctx, `DELETE FROM "user" WHERE id = $1`, delete.ID)
+	result, err := d.conn.ExecContext(ctx, `DELETE FROM "user" WHERE id = $1`, delete.ID)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/filter"
+)
+
+func TestConvertUserExprToSQL(t *testing.T) {
+	tests := []struct {
+		filter string
+		want   string
+		args   []any
+	}{
+		{
+			filter: `username == "steven"`,
+			want:   `"user".username = $1`,
+			args:   []any{"steven"},
+		},
+		{
+			filter: `nickname.contains("ste") || username.contains("ste")`,
+			want:   `("user".nickname ILIKE $1 OR "user".username ILIKE $2)`,
+			args:   []any{"%ste%", "%ste%"},
+		},
+		{
+			filter: `location == "Shanghai" && university != ""`,
+			want:   `((CASE WHEN "user".id IN (SELECT user_id FROM user_setting WHERE key = $1 AND value LIKE $2) THEN '' ELSE "user".location END) = $3 AND (CASE WHEN "user".id IN (SELECT user_id FROM user_setting WHERE key = $4 AND value LIKE $5) THEN '' ELSE "user".university END) != $6)`,
+			args:   []any{"PROFILE_PRIVACY", `%"location"%`, "Shanghai", "PROFILE_PRIVACY", `%"university"%`, ""},
+		},
+	}
+
+	for _, tt := range tests {
+		db := &DB{}
+		parsedExpr, err := filter.Parse(tt.filter, filter.UserFilterCELAttributes...)
+		require.NoError(t, err)
+		convertCtx := filter.NewConvertContext()
+		err = db.ConvertUserExprToSQL(convertCtx, parsedExpr.GetExpr())
+		require.NoError(t, err)
+		require.Equal(t, tt.want, convertCtx.Buffer.String())
+		require.Equal(t, tt.args, convertCtx.Args)
+	}
+}
@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateUserGroup(ctx context.Context, create *store.UserGroup) (*store.UserGroup, error) {
+	stmt := "INSERT INTO user_group (creator_id, name) VALUES (" + placeholders(2) + ") RETURNING id, created_ts"
+	if err := d.conn.QueryRowContext(ctx, stmt, create.CreatorID, create.Name).Scan(
+		&create.ID,
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+	userGroup := create
+	return userGroup, nil
+}
+
+func (d *DB) ListUserGroups(ctx context.Context, find *store.FindUserGroup) ([]*store.UserGroup, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.MemberID; v != nil {
+		where, args = append(where, "id IN (SELECT group_id FROM user_group_member WHERE user_id = "+placeholder(len(args)+1)+")"), append(args, *v)
+	}
+
+	rows, err := d.conn.QueryContext(ctx, "SELECT id, creator_id, created_ts, name FROM user_group WHERE "+strings.Join(where, " AND ")+" ORDER BY name, id", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.UserGroup{}
+	for rows.Next() {
+		userGroup := &store.UserGroup{}
+		if err := rows.Scan(
+			&userGroup.ID,
+			&userGroup.CreatorID,
+			&userGroup.CreatedTs,
+			&userGroup.Name,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, userGroup)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateUserGroup(ctx context.Context, update *store.UpdateUserGroup) (*store.UserGroup, error) {
+	set, args := []string{}, []any{}
+	if v := update.Name; v != nil {
+		set, args = append(set, "name = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	stmt := "UPDATE user_group SET " + strings.Join(set, ", ") + " WHERE id = " + placeholder(len(args)+1) + " RETURNING id, creator_id, created_ts, name"
+	args = append(args, update.ID)
+	userGroup := &store.UserGroup{}
+	if err := d.conn.QueryRowContext(ctx, stmt, args...).Scan(
+		&userGroup.ID,
+		&userGroup.CreatorID,
+		&userGroup.CreatedTs,
+		&userGroup.Name,
+	); err != nil {
+		return nil, err
+	}
+	return userGroup, nil
+}
+
+func (d *DB) DeleteUserGroup(ctx context.Context, delete *store.DeleteUserGroup) error {
+	if _, err := d.conn.ExecContext(ctx, "DELETE FROM user_group WHERE id = $1", delete.ID); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) CreateUserGroupMember(ctx context.Context, create *store.UserGroupMember) error {
+	stmt := "INSERT INTO user_group_member (group_id, user_id) VALUES (" + placeholders(2) + ") ON CONFLICT (group_id, user_id) DO NOTHING"
+	if _, err := d.conn.ExecContext(ctx, stmt, create.GroupID, create.UserID); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) ListUserGroupMembers(ctx context.Context, find *store.FindUserGroupMember) ([]*store.UserGroupMember, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.GroupID; v != nil {
+		where, args = append(where, "group_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.GroupIDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("group_id IN (%s)", strings.Join(holders, ", ")))
+	}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	rows, err := d.conn.QueryContext(ctx, "SELECT group_id, user_id, created_ts FROM user_group_member WHERE "+strings.Join(where, " AND ")+" ORDER BY group_id, user_id", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.UserGroupMember{}
+	for rows.Next() {
+		member := &store.UserGroupMember{}
+		if err := rows.Scan(&member.GroupID, &member.UserID, &member.CreatedTs); err != nil {
+			return nil, err
+		}
+		list = append(list, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteUserGroupMember(ctx context.Context, delete *store.DeleteUserGroupMember) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := delete.GroupID; v != nil {
+		where, args = append(where, "group_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := delete.UserID; v != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if _, err := d.conn.ExecContext(ctx, "DELETE FROM user_group_member WHERE "+strings.Join(where, " AND "), args...); err != nil {
+		return err
+	}
+	return nil
+}
@@ -11,7 +11,7 @@ func (d *DB) CreateWebhook(ctx context.Context, create *store.Webhook) (*store.W
 	fields := []string{"name", "url", "creator_id"}
 	args := []any{create.Name, create.URL, create.CreatorID}
 	stmt := "INSERT INTO webhook (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	if err := d.conn.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.CreatedTs,
 		&create.UpdatedTs,
@@ -31,7 +31,7 @@ func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*stor
 		where, args = append(where, "creator_id = "+placeholder(len(args)+1)), append(args, *find.CreatorID)
 	}
 
-	rows, err := d.db.QueryContext(ctx, `
+	rows, err := d.conn.QueryContext(ctx, `
 		SELECT
 			id,
 			created_ts,
@@ -84,7 +84,7 @@ func (d *DB) UpdateWebhook(ctx context.Context, update *store.UpdateWebhook) (*s
 	stmt := "UPDATE webhook SET " + strings.Join(set, ", ") + " WHERE id = " + placeholder(len(args)+1) + " RETURNING id, created_ts, updated_ts, creator_id, name, url"
 	args = append(args, update.ID)
 	webhook := &store.Webhook{}
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	if err := d.conn.QueryRowContext(ctx, stmt, args...).Scan(
 		&webhook.ID,
 		&webhook.CreatedTs,
 		&webhook.UpdatedTs,
@@ -98,6 +98,6 @@ func (d *DB) UpdateWebhook(ctx context.Context, update *store.UpdateWebhook) (*s
 }
 
 func (d *DB) DeleteWebhook(ctx context.Context, delete *store.DeleteWebhook) error {
-	_, err := d.db.ExecContext(ctx, "DELETE FROM webhook WHERE id = $1", delete.ID)
+	_, err := d.conn.ExecContext(ctx, "DELETE FROM webhook WHERE id = $1", delete.ID)
 	return err
 }
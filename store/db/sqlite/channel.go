@@ -0,0 +1,156 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateChannel(ctx context.Context, create *store.Channel) (*store.Channel, error) {
+	fields := []string{"`creator_id`", "`type`", "`name`"}
+	placeholder := []string{"?", "?", "?"}
+	args := []any{create.CreatorID, create.Type, create.Name}
+
+	stmt := "INSERT INTO `channel` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`, `updated_ts`"
+	if err := d.conn.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListChannels(ctx context.Context, find *store.FindChannel) ([]*store.Channel, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "`channel`.`id` = ?"), append(args, *v)
+	}
+	if v := find.MemberID; v != nil {
+		where, args = append(where, "`channel`.`id` IN (SELECT `channel_id` FROM `channel_member` WHERE `user_id` = ?)"), append(args, *v)
+	}
+	if v := find.Type; v != nil {
+		where, args = append(where, "`channel`.`type` = ?"), append(args, *v)
+	}
+
+	rows, err := d.conn.QueryContext(ctx, "SELECT `id`, `creator_id`, `created_ts`, `updated_ts`, `type`, `name` FROM `channel` WHERE "+strings.Join(where, " AND ")+" ORDER BY `updated_ts` DESC, `id` DESC", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Channel{}
+	for rows.Next() {
+		channel := &store.Channel{}
+		if err := rows.Scan(
+			&channel.ID,
+			&channel.CreatorID,
+			&channel.CreatedTs,
+			&channel.UpdatedTs,
+			&channel.Type,
+			&channel.Name,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, channel)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateChannel(ctx context.Context, update *store.UpdateChannel) error {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "`updated_ts` = ?"), append(args, *v)
+	}
+	if v := update.Name; v != nil {
+		set, args = append(set, "`name` = ?"), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+
+	stmt := "UPDATE `channel` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
+	args = append(args, update.ID)
+	if _, err := d.conn.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) CreateChannelMember(ctx context.Context, create *store.ChannelMember) (*store.ChannelMember, error) {
+	stmt := "INSERT INTO `channel_member` (`channel_id`, `user_id`, `last_read_message_id`) VALUES (?, ?, ?) RETURNING `created_ts`"
+	if err := d.conn.QueryRowContext(ctx, stmt, create.ChannelID, create.UserID, create.LastReadMessageID).Scan(&create.CreatedTs); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListChannelMembers(ctx context.Context, find *store.FindChannelMember) ([]*store.ChannelMember, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ChannelID; v != nil {
+		where, args = append(where, "`channel_id` = ?"), append(args, *v)
+	}
+	if v := find.ChannelIDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders = append(holders, "?")
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("`channel_id` IN (%s)", strings.Join(holders, ", ")))
+	}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *v)
+	}
+
+	rows, err := d.conn.QueryContext(ctx, "SELECT `channel_id`, `user_id`, `created_ts`, `last_read_message_id` FROM `channel_member` WHERE "+strings.Join(where, " AND ")+" ORDER BY `channel_id`, `created_ts`, `user_id`", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ChannelMember{}
+	for rows.Next() {
+		channelMember := &store.ChannelMember{}
+		if err := rows.Scan(
+			&channelMember.ChannelID,
+			&channelMember.UserID,
+			&channelMember.CreatedTs,
+			&channelMember.LastReadMessageID,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, channelMember)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateChannelMember(ctx context.Context, update *store.UpdateChannelMember) error {
+	set, args := []string{}, []any{}
+	if v := update.LastReadMessageID; v != nil {
+		set, args = append(set, "`last_read_message_id` = ?"), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+
+	stmt := "UPDATE `channel_member` SET " + strings.Join(set, ", ") + " WHERE `channel_id` = ? AND `user_id` = ?"
+	args = append(args, update.ChannelID, update.UserID)
+	if _, err := d.conn.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
@@ -27,7 +27,7 @@ func (d *DB) CreateInbox(ctx context.Context, create *store.Inbox) (*store.Inbox
 	args := []any{create.SenderID, create.ReceiverID, create.Status, messageString}
 
 	stmt := "INSERT INTO `inbox` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	if err := d.conn.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.CreatedTs,
 	); err != nil {
@@ -52,15 +52,18 @@ func (d *DB) ListInboxes(ctx context.Context, find *store.FindInbox) ([]*store.I
 	if find.Status != nil {
 		where, args = append(where, "`status` = ?"), append(args, *find.Status)
 	}
+	if v := find.Cursor; v != nil {
+		where, args = append(where, "(`created_ts` < ? OR (`created_ts` = ? AND `id` < ?))"), append(args, v.Ts, v.Ts, v.ID)
+	}
 
-	query := "SELECT `id`, `created_ts`, `sender_id`, `receiver_id`, `status`, `message` FROM `inbox` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC"
+	query := "SELECT `id`, `created_ts`, `sender_id`, `receiver_id`, `status`, `message` FROM `inbox` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC, `id` DESC"
 	if find.Limit != nil {
 		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
 		if find.Offset != nil {
 			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
 		}
 	}
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +105,7 @@ func (d *DB) UpdateInbox(ctx context.Context, update *store.UpdateInbox) (*store
 	query := "UPDATE `inbox` SET " + strings.Join(set, ", ") + " WHERE `id` = ? RETURNING `id`, `created_ts`, `sender_id`, `receiver_id`, `status`, `message`"
 	inbox := &store.Inbox{}
 	var messageBytes []byte
-	if err := d.db.QueryRowContext(ctx, query, args...).Scan(
+	if err := d.conn.QueryRowContext(ctx, query, args...).Scan(
 		&inbox.ID,
 		&inbox.CreatedTs,
 		&inbox.SenderID,
@@ -121,7 +124,7 @@ func (d *DB) UpdateInbox(ctx context.Context, update *store.UpdateInbox) (*store
 }
 
 func (d *DB) DeleteInbox(ctx context.Context, delete *store.DeleteInbox) error {
-	result, err := d.db.ExecContext(ctx, "DELETE FROM `inbox` WHERE `id` = ?", delete.ID)
+	result, err := d.conn.ExecContext(ctx, "DELETE FROM `inbox` WHERE `id` = ?", delete.ID)
 	if err != nil {
 		return err
 	}
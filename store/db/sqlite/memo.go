@@ -4,18 +4,20 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/usememos/memos/plugin/filter"
+	"github.com/usememos/memos/plugin/search"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
 func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, error) {
-	fields := []string{"`uid`", "`creator_id`", "`content`", "`visibility`", "`payload`"}
-	placeholder := []string{"?", "?", "?", "?", "?"}
+	fields := []string{"`uid`", "`creator_id`", "`content`", "`visibility`", "`payload`", "`changed_ts`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?"}
 	payload := "{}"
 	if create.Payload != nil {
 		payloadBytes, err := protojson.Marshal(create.Payload)
@@ -24,10 +26,11 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 		}
 		payload = string(payloadBytes)
 	}
-	args := []any{create.UID, create.CreatorID, create.Content, create.Visibility, payload}
+	create.ChangedTs = time.Now().Unix()
+	args := []any{create.UID, create.CreatorID, create.Content, create.Visibility, payload, create.ChangedTs}
 
 	stmt := "INSERT INTO `memo` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`, `updated_ts`, `row_status`"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	if err := d.conn.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.CreatedTs,
 		&create.UpdatedTs,
@@ -45,17 +48,34 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.ID; v != nil {
 		where, args = append(where, "`memo`.`id` = ?"), append(args, *v)
 	}
+	if v := find.IDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders = append(holders, "?")
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("`memo`.`id` IN (%s)", strings.Join(holders, ", ")))
+	}
 	if v := find.UID; v != nil {
 		where, args = append(where, "`memo`.`uid` = ?"), append(args, *v)
 	}
 	if v := find.CreatorID; v != nil {
 		if find.IsFollow {
-			where = append(where, "`memo`.`creator_id` IN (SELECT `following_user_id` FROM `user_following` WHERE `user_id` = ?)")
-			args = append(args, *v)
+			if tag := find.FollowTag; tag != nil {
+				where = append(where, "`memo`.`creator_id` IN (SELECT `following_user_id` FROM `user_following` WHERE `user_id` = ? AND `tag` = ?)")
+				args = append(args, *v, *tag)
+			} else {
+				where = append(where, "`memo`.`creator_id` IN (SELECT `following_user_id` FROM `user_following` WHERE `user_id` = ?)")
+				args = append(args, *v)
+			}
 		} else {
 			where, args = append(where, "`memo`.`creator_id` = ?"), append(args, *v)
 		}
 	}
+	if v := find.MuterID; v != nil {
+		where = append(where, "`memo`.`creator_id` NOT IN (SELECT `blocked_user_id` FROM `user_block` WHERE `user_id` = ? AND `type` = ?)")
+		args = append(args, *v, store.UserBlockTypeMute.String())
+	}
 	// if v := find.creatorIDs; v != nil {
 	// 	placeholders := make([]string, len(creatorIDs))
 	//         for i := range creatorIDs {
@@ -79,9 +99,17 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.UpdatedTsAfter; v != nil {
 		where, args = append(where, "`memo`.`updated_ts` > ?"), append(args, *v)
 	}
+	if v := find.ChangedTsAfter; v != nil {
+		where, args = append(where, "`memo`.`changed_ts` > ?"), append(args, *v)
+	}
+	if v := find.TrashedTsBefore; v != nil {
+		where, args = append(where, "`memo`.`trashed_ts` < ?"), append(args, *v)
+	}
 	if v := find.ContentSearch; len(v) != 0 {
 		for _, s := range v {
-			where, args = append(where, "`memo`.`content` LIKE ?"), append(args, fmt.Sprintf("%%%s%%", s))
+			for _, token := range search.Tokenize(s) {
+				where, args = append(where, "`memo`.`content` LIKE ?"), append(args, fmt.Sprintf("%%%s%%", token))
+			}
 		}
 	}
 	if v := find.VisibilityList; len(v) != 0 {
@@ -90,7 +118,20 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			placeholder = append(placeholder, "?")
 			args = append(args, visibility.String())
 		}
-		where = append(where, fmt.Sprintf("`memo`.`visibility` IN (%s)", strings.Join(placeholder, ",")))
+		condition := fmt.Sprintf("`memo`.`visibility` IN (%s)", strings.Join(placeholder, ","))
+		if principals := find.ACLPrincipals; len(principals) != 0 {
+			holders := []string{}
+			for _, principal := range principals {
+				holders = append(holders, "?")
+				args = append(args, principal)
+			}
+			condition = fmt.Sprintf("(%s OR `memo`.`id` IN (SELECT `memo_id` FROM `memo_acl` WHERE `principal` IN (%s)))", condition, strings.Join(holders, ", "))
+		}
+		if v := find.FollowerID; v != nil {
+			condition = fmt.Sprintf("(%s OR (`memo`.`visibility` = ? AND `memo`.`creator_id` IN (SELECT `following_user_id` FROM `user_following` WHERE `user_id` = ?)))", condition)
+			args = append(args, store.Followers.String(), *v)
+		}
+		where = append(where, condition)
 	}
 	if v := find.PayloadFind; v != nil {
 		if v.Raw != nil {
@@ -98,7 +139,8 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		}
 		if len(v.TagSearch) != 0 {
 			for _, tag := range v.TagSearch {
-				where, args = append(where, "(JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ? OR JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?)"), append(args, fmt.Sprintf(`%%"%s"%%`, tag), fmt.Sprintf(`%%"%s/%%`, tag))
+				// Match the tag and its sub tags.
+				where, args = append(where, "`memo`.`id` IN (SELECT `memo_id` FROM `memo_tag` WHERE `tag` = ? OR `tag` LIKE ? ESCAPE '\\')"), append(args, tag, escapeLikePattern(tag)+"/%")
 			}
 		}
 		// if v.Visibility != "" {
@@ -141,6 +183,29 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.ExcludeComments {
 		where = append(where, "`parent_id` IS NULL")
 	}
+	if find.ExcludeTrashedWithParent {
+		where = append(where, "NOT EXISTS (SELECT 1 FROM `memo` AS `parent` WHERE `parent`.`id` = `memo_relation`.`related_memo_id` AND `parent`.`row_status` = 'TRASHED' AND `parent`.`trashed_ts` = `memo`.`trashed_ts`)")
+	}
+	if v := find.Cursor; v != nil {
+		operator := "<"
+		if find.OrderByTimeAsc {
+			operator = ">"
+		}
+		tsField := "`memo`.`created_ts`"
+		if find.OrderByChangedTs {
+			tsField = "`memo`.`changed_ts`"
+		} else if find.OrderByUpdatedTs {
+			tsField = "`memo`.`updated_ts`"
+		}
+		condition := fmt.Sprintf("(%s %s ? OR (%s = ? AND `memo`.`id` %s ?))", tsField, operator, tsField, operator)
+		conditionArgs := []any{v.Ts, v.Ts, v.ID}
+		if find.OrderByPinned {
+			// Pinned memos always come first.
+			condition = fmt.Sprintf("(`memo`.`pinned` < ? OR (`memo`.`pinned` = ? AND %s))", condition)
+			conditionArgs = append([]any{v.Pinned, v.Pinned}, conditionArgs...)
+		}
+		where, args = append(where, condition), append(args, conditionArgs...)
+	}
 
 	orderBy := []string{}
 	if find.OrderByPinned {
@@ -150,7 +215,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.OrderByTimeAsc {
 		order = "ASC"
 	}
-	if find.OrderByUpdatedTs {
+	if find.OrderByChangedTs {
+		orderBy = append(orderBy, "`changed_ts` "+order)
+	} else if find.OrderByUpdatedTs {
 		orderBy = append(orderBy, "`updated_ts` "+order)
 	} else {
 		orderBy = append(orderBy, "`created_ts` "+order)
@@ -162,10 +229,13 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		"`memo`.`creator_id` AS `creator_id`",
 		"`memo`.`created_ts` AS `created_ts`",
 		"`memo`.`updated_ts` AS `updated_ts`",
+		"`memo`.`trashed_ts` AS `trashed_ts`",
 		"`memo`.`row_status` AS `row_status`",
 		"`memo`.`visibility` AS `visibility`",
 		"`memo`.`pinned` AS `pinned`",
 		"`memo`.`payload` AS `payload`",
+		"`memo`.`version` AS `version`",
+		"`memo`.`changed_ts` AS `changed_ts`",
 		"`memo_relation`.`related_memo_id` AS `parent_id`",
 	}
 	if !find.ExcludeContent {
@@ -183,7 +253,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		}
 	}
 
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -199,10 +269,13 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			&memo.CreatorID,
 			&memo.CreatedTs,
 			&memo.UpdatedTs,
+			&memo.TrashedTs,
 			&memo.RowStatus,
 			&memo.Visibility,
 			&memo.Pinned,
 			&payloadBytes,
+			&memo.Version,
+			&memo.ChangedTs,
 			&memo.ParentID,
 		}
 		if !find.ExcludeContent {
@@ -237,6 +310,9 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	if v := update.UpdatedTs; v != nil {
 		set, args = append(set, "`updated_ts` = ?"), append(args, *v)
 	}
+	if v := update.TrashedTs; v != nil {
+		set, args = append(set, "`trashed_ts` = ?"), append(args, *v)
+	}
 	if v := update.RowStatus; v != nil {
 		set, args = append(set, "`row_status` = ?"), append(args, *v)
 	}
@@ -259,19 +335,34 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	if len(set) == 0 {
 		return nil
 	}
+	set, args = append(set, "`version` = `version` + 1", "`changed_ts` = ?"), append(args, time.Now().Unix())
+	where := []string{"`id` = ?"}
 	args = append(args, update.ID)
+	if v := update.Version; v != nil {
+		where, args = append(where, "`version` = ?"), append(args, *v)
+	}
 
-	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE " + strings.Join(where, " AND ")
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
+	if err != nil {
 		return err
 	}
+	if update.Version != nil {
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return store.ErrMemoVersionMismatch
+		}
+	}
 	return nil
 }
 
 func (d *DB) DeleteMemo(ctx context.Context, delete *store.DeleteMemo) error {
 	where, args := []string{"`id` = ?"}, []any{delete.ID}
 	stmt := "DELETE FROM `memo` WHERE " + strings.Join(where, " AND ")
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,74 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertMemoACL(ctx context.Context, upsert *store.MemoACL) error {
+	stmt := "INSERT INTO `memo_acl` (`memo_id`, `principal`, `role`) VALUES (?, ?, ?) ON CONFLICT(`memo_id`, `principal`) DO UPDATE SET `role` = EXCLUDED.`role`"
+	if _, err := d.conn.ExecContext(ctx, stmt, upsert.MemoID, upsert.Principal, upsert.Role); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) ListMemoACLs(ctx context.Context, find *store.FindMemoACL) ([]*store.MemoACL, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.MemoID; v != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *v)
+	}
+	if v := find.MemoIDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders = append(holders, "?")
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("`memo_id` IN (%s)", strings.Join(holders, ", ")))
+	}
+	if v := find.PrincipalList; len(v) != 0 {
+		holders := []string{}
+		for _, principal := range v {
+			holders = append(holders, "?")
+			args = append(args, principal)
+		}
+		where = append(where, fmt.Sprintf("`principal` IN (%s)", strings.Join(holders, ", ")))
+	}
+
+	rows, err := d.conn.QueryContext(ctx, "SELECT `memo_id`, `principal`, `role` FROM `memo_acl` WHERE "+strings.Join(where, " AND ")+" ORDER BY `memo_id`, `principal`", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoACL{}
+	for rows.Next() {
+		memoACL := &store.MemoACL{}
+		if err := rows.Scan(&memoACL.MemoID, &memoACL.Principal, &memoACL.Role); err != nil {
+			return nil, err
+		}
+		list = append(list, memoACL)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteMemoACL(ctx context.Context, delete *store.DeleteMemoACL) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := delete.MemoID; v != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *v)
+	}
+	if v := delete.Principal; v != nil {
+		where, args = append(where, "`principal` = ?"), append(args, *v)
+	}
+	stmt := "DELETE FROM `memo_acl` WHERE " + strings.Join(where, " AND ")
+	if _, err := d.conn.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
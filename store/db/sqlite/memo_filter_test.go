@@ -16,24 +16,34 @@ func TestConvertExprToSQL(t *testing.T) {
 	}{
 		{
 			filter: `tag in ["tag1", "tag2"]`,
-			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ? OR JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?)",
-			args:   []any{`%"tag1"%`, `%"tag2"%`},
+			want:   "`memo`.`id` IN (SELECT `memo_id` FROM `memo_tag` WHERE `tag` IN (?, ?))",
+			args:   []any{"tag1", "tag2"},
 		},
 		{
 			filter: `!(tag in ["tag1", "tag2"])`,
-			want:   "NOT ((JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ? OR JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?))",
-			args:   []any{`%"tag1"%`, `%"tag2"%`},
+			want:   "NOT (`memo`.`id` IN (SELECT `memo_id` FROM `memo_tag` WHERE `tag` IN (?, ?)))",
+			args:   []any{"tag1", "tag2"},
 		},
 		{
 			filter: `tag in ["tag1", "tag2"] || tag in ["tag3", "tag4"]`,
-			want:   "((JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ? OR JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?) OR (JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ? OR JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?))",
-			args:   []any{`%"tag1"%`, `%"tag2"%`, `%"tag3"%`, `%"tag4"%`},
+			want:   "(`memo`.`id` IN (SELECT `memo_id` FROM `memo_tag` WHERE `tag` IN (?, ?)) OR `memo`.`id` IN (SELECT `memo_id` FROM `memo_tag` WHERE `tag` IN (?, ?)))",
+			args:   []any{"tag1", "tag2", "tag3", "tag4"},
 		},
 		{
 			filter: `content.contains("memos")`,
 			want:   "`memo`.`content` LIKE ?",
 			args:   []any{"%memos%"},
 		},
+		{
+			filter: `content.contains("中文")`,
+			want:   "`memo`.`content` LIKE ?",
+			args:   []any{"%中文%"},
+		},
+		{
+			filter: `content.contains("笔记应用")`,
+			want:   "(`memo`.`content` LIKE ? AND `memo`.`content` LIKE ? AND `memo`.`content` LIKE ?)",
+			args:   []any{"%笔记%", "%记应%", "%应用%"},
+		},
 		{
 			filter: `visibility in ["PUBLIC"]`,
 			want:   "`memo`.`visibility` IN (?)",
@@ -51,8 +61,8 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `tag in ['tag1'] || content.contains('hello')`,
-			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ? OR `memo`.`content` LIKE ?)",
-			args:   []any{`%"tag1"%`, "%hello%"},
+			want:   "(`memo`.`id` IN (SELECT `memo_id` FROM `memo_tag` WHERE `tag` IN (?)) OR `memo`.`content` LIKE ?)",
+			args:   []any{"tag1", "%hello%"},
 		},
 		{
 			filter: `1`,
@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/usememos/memos/store"
@@ -18,7 +19,7 @@ func (d *DB) UpsertMemoRelation(ctx context.Context, create *store.MemoRelation)
 		RETURNING memo_id, related_memo_id, type
 	`
 	memoRelation := &store.MemoRelation{}
-	if err := d.db.QueryRowContext(
+	if err := d.conn.QueryRowContext(
 		ctx,
 		stmt,
 		create.MemoID,
@@ -40,14 +41,30 @@ func (d *DB) ListMemoRelations(ctx context.Context, find *store.FindMemoRelation
 	if find.MemoID != nil {
 		where, args = append(where, "memo_id = ?"), append(args, find.MemoID)
 	}
+	if v := find.MemoIDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders = append(holders, "?")
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("memo_id IN (%s)", strings.Join(holders, ", ")))
+	}
 	if find.RelatedMemoID != nil {
 		where, args = append(where, "related_memo_id = ?"), append(args, find.RelatedMemoID)
 	}
+	if v := find.RelatedMemoIDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders = append(holders, "?")
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("related_memo_id IN (%s)", strings.Join(holders, ", ")))
+	}
 	if find.Type != nil {
 		where, args = append(where, "type = ?"), append(args, find.Type)
 	}
 
-	rows, err := d.db.QueryContext(ctx, `
+	rows, err := d.conn.QueryContext(ctx, `
 		SELECT
 			memo_id,
 			related_memo_id,
@@ -93,7 +110,7 @@ func (d *DB) DeleteMemoRelation(ctx context.Context, delete *store.DeleteMemoRel
 	stmt := `
 		DELETE FROM memo_relation
 		WHERE ` + strings.Join(where, " AND ")
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return err
 	}
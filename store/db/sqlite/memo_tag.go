@@ -0,0 +1,118 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertMemoTag(ctx context.Context, upsert *store.MemoTag) error {
+	stmt := "INSERT OR IGNORE INTO `memo_tag` (`memo_id`, `tag`) VALUES (?, ?)"
+	if _, err := d.conn.ExecContext(ctx, stmt, upsert.MemoID, upsert.Tag); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) ListMemoTags(ctx context.Context, find *store.FindMemoTag) ([]*store.MemoTag, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.MemoID; v != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *v)
+	}
+	if v := find.MemoIDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders = append(holders, "?")
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("`memo_id` IN (%s)", strings.Join(holders, ", ")))
+	}
+	if v := find.Tag; v != nil {
+		where, args = append(where, "`tag` = ?"), append(args, *v)
+	}
+
+	rows, err := d.conn.QueryContext(ctx, "SELECT `memo_id`, `tag` FROM `memo_tag` WHERE "+strings.Join(where, " AND ")+" ORDER BY `memo_id`, `tag`", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoTag{}
+	for rows.Next() {
+		memoTag := &store.MemoTag{}
+		if err := rows.Scan(&memoTag.MemoID, &memoTag.Tag); err != nil {
+			return nil, err
+		}
+		list = append(list, memoTag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteMemoTag(ctx context.Context, delete *store.DeleteMemoTag) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := delete.MemoID; v != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *v)
+	}
+	if v := delete.Tag; v != nil {
+		where, args = append(where, "`tag` = ?"), append(args, *v)
+	}
+	stmt := "DELETE FROM `memo_tag` WHERE " + strings.Join(where, " AND ")
+	if _, err := d.conn.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) CountMemoTags(ctx context.Context, find *store.FindMemoTagCount) ([]*store.MemoTagCount, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "`memo`.`creator_id` = ?"), append(args, *v)
+	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, "`memo`.`row_status` = ?"), append(args, *v)
+	}
+	if v := find.VisibilityList; len(v) != 0 {
+		holders := []string{}
+		for _, visibility := range v {
+			holders = append(holders, "?")
+			args = append(args, visibility.String())
+		}
+		where = append(where, fmt.Sprintf("`memo`.`visibility` IN (%s)", strings.Join(holders, ",")))
+	}
+	if find.ExcludeComments {
+		where = append(where, "NOT EXISTS (SELECT 1 FROM `memo_relation` WHERE `memo_relation`.`memo_id` = `memo`.`id` AND `memo_relation`.`type` = 'COMMENT')")
+	}
+
+	query := "SELECT `memo`.`creator_id`, `memo_tag`.`tag`, COUNT(*), MAX(`memo`.`updated_ts`) FROM `memo_tag` " +
+		"JOIN `memo` ON `memo`.`id` = `memo_tag`.`memo_id` " +
+		"WHERE " + strings.Join(where, " AND ") + " " +
+		"GROUP BY `memo`.`creator_id`, `memo_tag`.`tag`"
+	rows, err := d.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoTagCount{}
+	for rows.Next() {
+		memoTagCount := &store.MemoTagCount{}
+		if err := rows.Scan(&memoTagCount.CreatorID, &memoTagCount.Tag, &memoTagCount.Count, &memoTagCount.LastUsedTs); err != nil {
+			return nil, err
+		}
+		list = append(list, memoTagCount)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// escapeLikePattern escapes the wildcards in s so it matches literally in a LIKE pattern.
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
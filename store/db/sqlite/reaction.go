@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/usememos/memos/store"
@@ -12,7 +13,7 @@ func (d *DB) UpsertReaction(ctx context.Context, upsert *store.Reaction) (*store
 	placeholder := []string{"?", "?", "?"}
 	args := []interface{}{upsert.CreatorID, upsert.ContentID, upsert.ReactionType}
 	stmt := "INSERT INTO `reaction` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	if err := d.conn.QueryRowContext(ctx, stmt, args...).Scan(
 		&upsert.ID,
 		&upsert.CreatedTs,
 	); err != nil {
@@ -34,8 +35,16 @@ func (d *DB) ListReactions(ctx context.Context, find *store.FindReaction) ([]*st
 	if find.ContentID != nil {
 		where, args = append(where, "content_id = ?"), append(args, *find.ContentID)
 	}
+	if v := find.ContentIDList; len(v) != 0 {
+		holders := []string{}
+		for _, contentID := range v {
+			holders = append(holders, "?")
+			args = append(args, contentID)
+		}
+		where = append(where, fmt.Sprintf("content_id IN (%s)", strings.Join(holders, ", ")))
+	}
 
-	rows, err := d.db.QueryContext(ctx, `
+	rows, err := d.conn.QueryContext(ctx, `
 		SELECT
 			id,
 			created_ts,
@@ -75,6 +84,6 @@ func (d *DB) ListReactions(ctx context.Context, find *store.FindReaction) ([]*st
 }
 
 func (d *DB) DeleteReaction(ctx context.Context, delete *store.DeleteReaction) error {
-	_, err := d.db.ExecContext(ctx, "DELETE FROM `reaction` WHERE `id` = ?", delete.ID)
+	_, err := d.conn.ExecContext(ctx, "DELETE FROM `reaction` WHERE `id` = ?", delete.ID)
 	return err
 }
@@ -31,7 +31,7 @@ func (d *DB) CreateResource(ctx context.Context, create *store.Resource) (*store
 	args := []any{create.UID, create.Filename, create.Blob, create.Type, create.Size, create.CreatorID, create.MemoID, storageType, create.Reference, payloadString}
 
 	stmt := "INSERT INTO `resource` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`, `updated_ts`"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(&create.ID, &create.CreatedTs, &create.UpdatedTs); err != nil {
+	if err := d.conn.QueryRowContext(ctx, stmt, args...).Scan(&create.ID, &create.CreatedTs, &create.UpdatedTs); err != nil {
 		return nil, err
 	}
 
@@ -59,14 +59,25 @@ func (d *DB) ListResources(ctx context.Context, find *store.FindResource) ([]*st
 	if v := find.MemoID; v != nil {
 		where, args = append(where, "`memo_id` = ?"), append(args, *v)
 	}
+	if v := find.MemoIDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders = append(holders, "?")
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("`memo_id` IN (%s)", strings.Join(holders, ", ")))
+	}
 	if find.HasRelatedMemo {
 		where = append(where, "`memo_id` IS NOT NULL")
 	}
 	if find.StorageType != nil {
 		where, args = append(where, "`storage_type` = ?"), append(args, find.StorageType.String())
 	}
+	if v := find.UpdatedTsAfter; v != nil {
+		where, args = append(where, "`updated_ts` > ?"), append(args, *v)
+	}
 
-	fields := []string{"`id`", "`uid`", "`filename`", "`type`", "`size`", "`creator_id`", "`created_ts`", "`updated_ts`", "`memo_id`", "`storage_type`", "`reference`", "`payload`"}
+	fields := []string{"`id`", "`uid`", "`filename`", "`type`", "`size`", "`creator_id`", "`created_ts`", "`updated_ts`", "`memo_id`", "`storage_type`", "`reference`", "`payload`", "`version`"}
 	if find.GetBlob {
 		fields = append(fields, "`blob`")
 	}
@@ -79,7 +90,7 @@ func (d *DB) ListResources(ctx context.Context, find *store.FindResource) ([]*st
 		}
 	}
 
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +115,7 @@ func (d *DB) ListResources(ctx context.Context, find *store.FindResource) ([]*st
 			&storageType,
 			&resource.Reference,
 			&payloadBytes,
+			&resource.Version,
 		}
 		if find.GetBlob {
 			dests = append(dests, &resource.Blob)
@@ -157,21 +169,32 @@ func (d *DB) UpdateResource(ctx context.Context, update *store.UpdateResource) e
 		set, args = append(set, "`payload` = ?"), append(args, string(bytes))
 	}
 
-	args = append(args, update.ID)
-	stmt := "UPDATE `resource` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	set = append(set, "`version` = `version` + 1")
+
+	where, args := []string{"`id` = ?"}, append(args, update.ID)
+	if v := update.Version; v != nil {
+		where, args = append(where, "`version` = ?"), append(args, *v)
+	}
+	stmt := "UPDATE `resource` SET " + strings.Join(set, ", ") + " WHERE " + strings.Join(where, " AND ")
+	result, err := d.conn.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return errors.Wrap(err, "failed to update resource")
 	}
-	if _, err := result.RowsAffected(); err != nil {
-		return err
+	if update.Version != nil {
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return store.ErrResourceVersionMismatch
+		}
 	}
 	return nil
 }
 
 func (d *DB) DeleteResource(ctx context.Context, delete *store.DeleteResource) error {
 	stmt := "DELETE FROM `resource` WHERE `id` = ?"
-	result, err := d.db.ExecContext(ctx, stmt, delete.ID)
+	result, err := d.conn.ExecContext(ctx, stmt, delete.ID)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package sqlite
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/pkg/errors"
@@ -13,7 +14,10 @@ import (
 )
 
 type DB struct {
-	db      *sql.DB
+	db *sql.DB
+	// conn runs the queries, it is either db or tx.
+	conn    store.DBTX
+	tx      *sql.Tx
 	profile *profile.Profile
 }
 
@@ -45,7 +49,7 @@ func NewDB(profile *profile.Profile) (store.Driver, error) {
 		return nil, errors.Wrapf(err, "failed to open db with dsn: %s", profile.DSN)
 	}
 
-	driver := DB{db: sqliteDB, profile: profile}
+	driver := DB{db: sqliteDB, conn: sqliteDB, profile: profile}
 
 	return &driver, nil
 }
@@ -55,5 +59,36 @@ func (d *DB) GetDB() *sql.DB {
 }
 
 func (d *DB) Close() error {
+	if d.tx != nil {
+		return errors.New("cannot close the database in a transaction")
+	}
 	return d.db.Close()
 }
+
+func (d *DB) BeginTx(ctx context.Context) (store.TxDriver, error) {
+	if d.tx != nil {
+		return nil, errors.New("nested transactions are not supported")
+	}
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin transaction")
+	}
+	txDriver := *d
+	txDriver.conn = tx
+	txDriver.tx = tx
+	return &txDriver, nil
+}
+
+func (d *DB) Commit() error {
+	if d.tx == nil {
+		return errors.New("not in a transaction")
+	}
+	return d.tx.Commit()
+}
+
+func (d *DB) Rollback() error {
+	if d.tx == nil {
+		return errors.New("not in a transaction")
+	}
+	return d.tx.Rollback()
+}
@@ -0,0 +1,68 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateTombstone(ctx context.Context, create *store.Tombstone) (*store.Tombstone, error) {
+	fields := []string{"`creator_id`", "`type`", "`uid`", "`reason`"}
+	placeholder := []string{"?", "?", "?", "?"}
+	args := []any{create.CreatorID, create.Type, create.UID, create.Reason}
+
+	stmt := "INSERT INTO `tombstone` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`"
+	if err := d.conn.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListTombstones(ctx context.Context, find *store.FindTombstone) ([]*store.Tombstone, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *v)
+	}
+	if v := find.Type; v != nil {
+		where, args = append(where, "`type` = ?"), append(args, *v)
+	}
+	if v := find.CreatedTsAfter; v != nil {
+		where, args = append(where, "`created_ts` > ?"), append(args, *v)
+	}
+
+	rows, err := d.conn.QueryContext(ctx, "SELECT `id`, `creator_id`, `created_ts`, `type`, `uid`, `reason` FROM `tombstone` WHERE "+strings.Join(where, " AND ")+" ORDER BY `created_ts` ASC, `id` ASC", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Tombstone{}
+	for rows.Next() {
+		tombstone := &store.Tombstone{}
+		if err := rows.Scan(
+			&tombstone.ID,
+			&tombstone.CreatorID,
+			&tombstone.CreatedTs,
+			&tombstone.Type,
+			&tombstone.UID,
+			&tombstone.Reason,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, tombstone)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/usememos/memos/plugin/filter"
 	"github.com/usememos/memos/store"
 )
 
@@ -15,7 +16,7 @@ func (d *DB) CreateUser(ctx context.Context, create *store.User) (*store.User, e
 	placeholder := []string{"?", "?", "?", "?", "?"}
 	args := []any{create.Username, create.Role, create.Email, create.Nickname, create.PasswordHash}
 	stmt := "INSERT INTO user (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING id, avatar_url, description, created_ts, updated_ts, row_status"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	if err := d.conn.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.AvatarURL,
 		&create.Description,
@@ -82,7 +83,8 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 		RETURNING id, username, role, email, nickname, password_hash, avatar_url, description, gender, birth_date, location, industry, occupation, university, created_ts, updated_ts, row_status
 	`
 	user := &store.User{}
-	if err := d.db.QueryRowContext(ctx, query, args...).Scan(
+	var birthDate sql.NullTime
+	if err := d.conn.QueryRowContext(ctx, query, args...).Scan(
 		&user.ID,
 		&user.Username,
 		&user.Role,
@@ -92,7 +94,7 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 		&user.AvatarURL,
 		&user.Description,
 		&user.Gender,
-		&user.BirthDate,
+		&birthDate,
 		&user.Location,
 		&user.Industry,
 		&user.Occupation,
@@ -103,6 +105,10 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 	); err != nil {
 		return nil, err
 	}
+	// The birth date is unset until the user fills it in.
+	if birthDate.Valid {
+		user.BirthDate = birthDate.Time
+	}
 
 	return user, nil
 }
@@ -125,6 +131,23 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 	if v := find.Nickname; v != nil {
 		where, args = append(where, "nickname = ?"), append(args, *v)
 	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, "row_status = ?"), append(args, *v)
+	}
+	if v := find.Filter; v != nil {
+		parsedExpr, err := filter.Parse(*v, filter.UserFilterCELAttributes...)
+		if err != nil {
+			return nil, err
+		}
+		convertCtx := filter.NewConvertContext()
+		if err := d.ConvertUserExprToSQL(convertCtx, parsedExpr.GetExpr()); err != nil {
+			return nil, err
+		}
+		if condition := convertCtx.Buffer.String(); condition != "" {
+			where = append(where, fmt.Sprintf("(%s)", condition))
+			args = append(args, convertCtx.Args...)
+		}
+	}
 
 	orderBy := []string{"created_ts DESC", "row_status DESC"}
 	query := `
@@ -150,9 +173,12 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 		WHERE ` + strings.Join(where, " AND ") + ` ORDER BY ` + strings.Join(orderBy, ", ")
 	if v := find.Limit; v != nil {
 		query += fmt.Sprintf(" LIMIT %d", *v)
+		if v := find.Offset; v != nil {
+			query += fmt.Sprintf(" OFFSET %d", *v)
+		}
 	}
 
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -210,7 +236,7 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 }
 
 func (d *DB) DeleteUser(ctx context.Context, delete *store.DeleteUser) error {
-	result, err := d.db.ExecContext(ctx, `
+	result, err := d.conn.ExecContext(ctx, `
 		DELETE FROM user WHERE id = ?
 	`, delete.ID)
 	if err != nil {
@@ -0,0 +1,76 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertUserBlock(ctx context.Context, upsert *store.UserBlock) (*store.UserBlock, error) {
+	stmt := "INSERT INTO `user_block` (`user_id`, `blocked_user_id`, `type`) VALUES (?, ?, ?) ON CONFLICT(`user_id`, `blocked_user_id`, `type`) DO NOTHING"
+	if _, err := d.conn.ExecContext(ctx, stmt, upsert.UserID, upsert.BlockedUserID, upsert.Type.String()); err != nil {
+		return nil, err
+	}
+
+	list, err := d.ListUserBlocks(ctx, &store.FindUserBlock{
+		UserID:        &upsert.UserID,
+		BlockedUserID: &upsert.BlockedUserID,
+		Type:          &upsert.Type,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("failed to upsert user block")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListUserBlocks(ctx context.Context, find *store.FindUserBlock) ([]*store.UserBlock, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *v)
+	}
+	if v := find.BlockedUserID; v != nil {
+		where, args = append(where, "`blocked_user_id` = ?"), append(args, *v)
+	}
+	if v := find.Type; v != nil {
+		where, args = append(where, "`type` = ?"), append(args, v.String())
+	}
+
+	rows, err := d.conn.QueryContext(ctx, "SELECT `user_id`, `blocked_user_id`, `type`, `created_ts` FROM `user_block` WHERE "+strings.Join(where, " AND ")+" ORDER BY `created_ts` DESC, `id` DESC", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.UserBlock{}
+	for rows.Next() {
+		userBlock := &store.UserBlock{}
+		if err := rows.Scan(
+			&userBlock.UserID,
+			&userBlock.BlockedUserID,
+			&userBlock.Type,
+			&userBlock.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, userBlock)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteUserBlock(ctx context.Context, delete *store.DeleteUserBlock) error {
+	if _, err := d.conn.ExecContext(ctx, "DELETE FROM `user_block` WHERE `user_id` = ? AND `blocked_user_id` = ? AND `type` = ?", delete.UserID, delete.BlockedUserID, delete.Type.String()); err != nil {
+		return err
+	}
+	return nil
+}
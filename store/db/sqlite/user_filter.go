@@ -0,0 +1,125 @@
+package sqlite
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/pkg/errors"
+	exprv1 "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+
+	"github.com/usememos/memos/plugin/filter"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+var userFilterIdentifiers = []string{"username", "nickname", "description", "location", "industry", "occupation", "university"}
+
+func (d *DB) ConvertUserExprToSQL(ctx *filter.ConvertContext, expr *exprv1.Expr) error {
+	v, ok := expr.ExprKind.(*exprv1.Expr_CallExpr)
+	if !ok {
+		return errors.New("invalid expression")
+	}
+	switch v.CallExpr.Function {
+	case "_||_", "_&&_":
+		if len(v.CallExpr.Args) != 2 {
+			return errors.Errorf("invalid number of arguments for %s", v.CallExpr.Function)
+		}
+		if _, err := ctx.Buffer.WriteString("("); err != nil {
+			return err
+		}
+		if err := d.ConvertUserExprToSQL(ctx, v.CallExpr.Args[0]); err != nil {
+			return err
+		}
+		operator := "AND"
+		if v.CallExpr.Function == "_||_" {
+			operator = "OR"
+		}
+		if _, err := ctx.Buffer.WriteString(fmt.Sprintf(" %s ", operator)); err != nil {
+			return err
+		}
+		if err := d.ConvertUserExprToSQL(ctx, v.CallExpr.Args[1]); err != nil {
+			return err
+		}
+		if _, err := ctx.Buffer.WriteString(")"); err != nil {
+			return err
+		}
+	case "!_":
+		if len(v.CallExpr.Args) != 1 {
+			return errors.Errorf("invalid number of arguments for %s", v.CallExpr.Function)
+		}
+		if _, err := ctx.Buffer.WriteString("NOT ("); err != nil {
+			return err
+		}
+		if err := d.ConvertUserExprToSQL(ctx, v.CallExpr.Args[0]); err != nil {
+			return err
+		}
+		if _, err := ctx.Buffer.WriteString(")"); err != nil {
+			return err
+		}
+	case "_==_", "_!=_":
+		if len(v.CallExpr.Args) != 2 {
+			return errors.Errorf("invalid number of arguments for %s", v.CallExpr.Function)
+		}
+		identifier, err := filter.GetIdentExprName(v.CallExpr.Args[0])
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(userFilterIdentifiers, identifier) {
+			return errors.Errorf("invalid identifier for %s", v.CallExpr.Function)
+		}
+		value, err := filter.GetConstValue(v.CallExpr.Args[1])
+		if err != nil {
+			return err
+		}
+		valueStr, ok := value.(string)
+		if !ok {
+			return errors.New("invalid string value")
+		}
+		operator := "="
+		if v.CallExpr.Function == "_!=_" {
+			operator = "!="
+		}
+		factor := convertUserFieldToSQL(ctx, identifier)
+		if _, err := ctx.Buffer.WriteString(fmt.Sprintf("%s %s ?", factor, operator)); err != nil {
+			return err
+		}
+		ctx.Args = append(ctx.Args, valueStr)
+	case "contains":
+		if len(v.CallExpr.Args) != 1 {
+			return errors.Errorf("invalid number of arguments for %s", v.CallExpr.Function)
+		}
+		identifier, err := filter.GetIdentExprName(v.CallExpr.Target)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(userFilterIdentifiers, identifier) {
+			return errors.Errorf("invalid identifier for %s", v.CallExpr.Function)
+		}
+		arg, err := filter.GetConstValue(v.CallExpr.Args[0])
+		if err != nil {
+			return err
+		}
+		argStr, ok := arg.(string)
+		if !ok {
+			return errors.New("invalid string value")
+		}
+		factor := convertUserFieldToSQL(ctx, identifier)
+		if _, err := ctx.Buffer.WriteString(fmt.Sprintf("%s LIKE ?", factor)); err != nil {
+			return err
+		}
+		ctx.Args = append(ctx.Args, fmt.Sprintf("%%%s%%", argStr))
+	default:
+		return errors.Errorf("unsupported function %s", v.CallExpr.Function)
+	}
+	return nil
+}
+
+// convertUserFieldToSQL returns the column of the field, the private field of a user is empty.
+func convertUserFieldToSQL(ctx *filter.ConvertContext, field string) string {
+	column := fmt.Sprintf("`user`.`%s`", field)
+	if !slices.Contains(store.PrivateProfileFields, field) {
+		return column
+	}
+	ctx.Args = append(ctx.Args, storepb.UserSettingKey_PROFILE_PRIVACY.String(), fmt.Sprintf("%%%q%%", field))
+	return fmt.Sprintf("(CASE WHEN `user`.`id` IN (SELECT `user_id` FROM `user_setting` WHERE `key` = ? AND `value` LIKE ?) THEN '' ELSE %s END)", column)
+}
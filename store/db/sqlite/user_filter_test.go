@@ -0,0 +1,49 @@
+package sqlite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/filter"
+)
+
+func TestConvertUserExprToSQL(t *testing.T) {
+	tests := []struct {
+		filter string
+		want   string
+		args   []any
+	}{
+		{
+			filter: `username == "steven"`,
+			want:   "`user`.`username` = ?",
+			args:   []any{"steven"},
+		},
+		{
+			filter: `nickname.contains("ste") || username.contains("ste")`,
+			want:   "(`user`.`nickname` LIKE ? OR `user`.`username` LIKE ?)",
+			args:   []any{"%ste%", "%ste%"},
+		},
+		{
+			filter: `location == "Shanghai"`,
+			want:   "(CASE WHEN `user`.`id` IN (SELECT `user_id` FROM `user_setting` WHERE `key` = ? AND `value` LIKE ?) THEN '' ELSE `user`.`location` END) = ?",
+			args:   []any{"PROFILE_PRIVACY", `%"location"%`, "Shanghai"},
+		},
+		{
+			filter: `!(industry.contains("tech"))`,
+			want:   "NOT ((CASE WHEN `user`.`id` IN (SELECT `user_id` FROM `user_setting` WHERE `key` = ? AND `value` LIKE ?) THEN '' ELSE `user`.`industry` END) LIKE ?)",
+			args:   []any{"PROFILE_PRIVACY", `%"industry"%`, "%tech%"},
+		},
+	}
+
+	for _, tt := range tests {
+		db := &DB{}
+		parsedExpr, err := filter.Parse(tt.filter, filter.UserFilterCELAttributes...)
+		require.NoError(t, err)
+		convertCtx := filter.NewConvertContext()
+		err = db.ConvertUserExprToSQL(convertCtx, parsedExpr.GetExpr())
+		require.NoError(t, err)
+		require.Equal(t, tt.want, convertCtx.Buffer.String())
+		require.Equal(t, tt.args, convertCtx.Args)
+	}
+}
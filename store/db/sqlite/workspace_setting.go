@@ -18,7 +18,7 @@ func (d *DB) UpsertWorkspaceSetting(ctx context.Context, upsert *store.Workspace
 			value = EXCLUDED.value,
 			description = EXCLUDED.description
 	`
-	if _, err := d.db.ExecContext(ctx, stmt, upsert.Name, upsert.Value, upsert.Description); err != nil {
+	if _, err := d.conn.ExecContext(ctx, stmt, upsert.Name, upsert.Value, upsert.Description); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (d *DB) ListWorkspaceSettings(ctx context.Context, find *store.FindWorkspac
 		FROM system_setting
 		WHERE ` + strings.Join(where, " AND ")
 
-	rows, err := d.db.QueryContext(ctx, query, args...)
+	rows, err := d.conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -67,6 +67,6 @@ func (d *DB) ListWorkspaceSettings(ctx context.Context, find *store.FindWorkspac
 
 func (d *DB) DeleteWorkspaceSetting(ctx context.Context, delete *store.DeleteWorkspaceSetting) error {
 	stmt := "DELETE FROM system_setting WHERE name = ?"
-	_, err := d.db.ExecContext(ctx, stmt, delete.Name)
+	_, err := d.conn.ExecContext(ctx, stmt, delete.Name)
 	return err
 }
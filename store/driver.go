@@ -9,11 +9,29 @@ import (
 	"github.com/usememos/memos/plugin/filter"
 )
 
+// DBTX is the common interface of *sql.DB and *sql.Tx that the drivers run queries on.
+type DBTX interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// TxDriver is a store driver bound to a transaction.
+type TxDriver interface {
+	Driver
+	Commit() error
+	Rollback() error
+}
+
 // Driver is an interface for store driver.
 // It contains all methods that store database driver should implement.
 type Driver interface {
 	GetDB() *sql.DB
 	Close() error
+	// BeginTx starts a transaction, the returned driver runs all its queries in it.
+	BeginTx(ctx context.Context) (TxDriver, error)
 
 	// MigrationHistory model related methods.
 	FindMigrationHistoryList(ctx context.Context, find *FindMigrationHistory) ([]*MigrationHistory, error)
@@ -34,6 +52,52 @@ type Driver interface {
 	ListMemos(ctx context.Context, find *FindMemo) ([]*Memo, error)
 	UpdateMemo(ctx context.Context, update *UpdateMemo) error
 	DeleteMemo(ctx context.Context, delete *DeleteMemo) error
+	SearchMemos(ctx context.Context, find *SearchMemo) ([]*MemoSearchResult, error)
+
+	// MemoRevision model related methods.
+	CreateMemoRevision(ctx context.Context, create *MemoRevision) (*MemoRevision, error)
+	ListMemoRevisions(ctx context.Context, find *FindMemoRevision) ([]*MemoRevision, error)
+	DeleteMemoRevision(ctx context.Context, delete *DeleteMemoRevision) error
+
+	// MemoTag model related methods.
+	UpsertMemoTag(ctx context.Context, upsert *MemoTag) error
+	ListMemoTags(ctx context.Context, find *FindMemoTag) ([]*MemoTag, error)
+	DeleteMemoTag(ctx context.Context, delete *DeleteMemoTag) error
+	CountMemoTags(ctx context.Context, find *FindMemoTagCount) ([]*MemoTagCount, error)
+
+	// MemoACL model related methods.
+	UpsertMemoACL(ctx context.Context, upsert *MemoACL) error
+	ListMemoACLs(ctx context.Context, find *FindMemoACL) ([]*MemoACL, error)
+	DeleteMemoACL(ctx context.Context, delete *DeleteMemoACL) error
+
+	// MemoShare model related methods.
+	CreateMemoShare(ctx context.Context, create *MemoShare) (*MemoShare, error)
+	ListMemoShares(ctx context.Context, find *FindMemoShare) ([]*MemoShare, error)
+	IncreaseMemoShareViewCount(ctx context.Context, id int32) (bool, error)
+	DeleteMemoShare(ctx context.Context, delete *DeleteMemoShare) error
+
+	// Tombstone model related methods.
+	CreateTombstone(ctx context.Context, create *Tombstone) (*Tombstone, error)
+	ListTombstones(ctx context.Context, find *FindTombstone) ([]*Tombstone, error)
+
+	// IdempotencyKey model related methods.
+	CreateIdempotencyKey(ctx context.Context, create *IdempotencyKey) (*IdempotencyKey, error)
+	ListIdempotencyKeys(ctx context.Context, find *FindIdempotencyKey) ([]*IdempotencyKey, error)
+	UpdateIdempotencyKey(ctx context.Context, update *UpdateIdempotencyKey) error
+	DeleteIdempotencyKey(ctx context.Context, delete *DeleteIdempotencyKey) error
+
+	// Channel model related methods.
+	CreateChannel(ctx context.Context, create *Channel) (*Channel, error)
+	ListChannels(ctx context.Context, find *FindChannel) ([]*Channel, error)
+	UpdateChannel(ctx context.Context, update *UpdateChannel) error
+	CreateChannelMember(ctx context.Context, create *ChannelMember) (*ChannelMember, error)
+	ListChannelMembers(ctx context.Context, find *FindChannelMember) ([]*ChannelMember, error)
+	UpdateChannelMember(ctx context.Context, update *UpdateChannelMember) error
+
+	// Message model related methods.
+	CreateMessage(ctx context.Context, create *Message) (*Message, error)
+	ListMessages(ctx context.Context, find *FindMessage) ([]*Message, error)
+	CountUnreadMessages(ctx context.Context, userID int32) (map[int32]int32, error)
 
 	// MemoRelation model related methods.
 	UpsertMemoRelation(ctx context.Context, create *MemoRelation) (*MemoRelation, error)
@@ -57,6 +121,27 @@ type Driver interface {
 	UnFollowUser(ctx context.Context, UnFollow *UserFollowing) error
 	GetFollowingList(ctx context.Context, userID int32) ([]*User, error)
 	GetFollowerList(ctx context.Context, userID int32) ([]*User, error)
+	ListUserFollowings(ctx context.Context, find *FindUserFollowing) ([]*UserFollowing, error)
+	UpdateUserFollowing(ctx context.Context, update *UpdateUserFollowing) error
+
+	// FollowRequest model related methods.
+	CreateFollowRequest(ctx context.Context, create *FollowRequest) (*FollowRequest, error)
+	ListFollowRequests(ctx context.Context, find *FindFollowRequest) ([]*FollowRequest, error)
+	DeleteFollowRequest(ctx context.Context, delete *DeleteFollowRequest) error
+
+	// UserBlock model related methods.
+	UpsertUserBlock(ctx context.Context, upsert *UserBlock) (*UserBlock, error)
+	ListUserBlocks(ctx context.Context, find *FindUserBlock) ([]*UserBlock, error)
+	DeleteUserBlock(ctx context.Context, delete *DeleteUserBlock) error
+
+	// UserGroup model related methods.
+	CreateUserGroup(ctx context.Context, create *UserGroup) (*UserGroup, error)
+	ListUserGroups(ctx context.Context, find *FindUserGroup) ([]*UserGroup, error)
+	UpdateUserGroup(ctx context.Context, update *UpdateUserGroup) (*UserGroup, error)
+	DeleteUserGroup(ctx context.Context, delete *DeleteUserGroup) error
+	CreateUserGroupMember(ctx context.Context, create *UserGroupMember) error
+	ListUserGroupMembers(ctx context.Context, find *FindUserGroupMember) ([]*UserGroupMember, error)
+	DeleteUserGroupMember(ctx context.Context, delete *DeleteUserGroupMember) error
 
 	// UserSetting model related methods.
 	UpsertUserSetting(ctx context.Context, upsert *UserSetting) (*UserSetting, error)
@@ -0,0 +1,47 @@
+package store
+
+import (
+	"context"
+)
+
+// FollowRequest is a request to follow a user approving their followers.
+// The requester doesn't follow the user until the request is accepted.
+type FollowRequest struct {
+	ID          int32
+	CreatedTs   int64
+	RequesterID int32
+	UserID      int32
+}
+
+type FindFollowRequest struct {
+	ID          *int32
+	RequesterID *int32
+	UserID      *int32
+}
+
+type DeleteFollowRequest struct {
+	ID int32
+}
+
+func (s *Store) CreateFollowRequest(ctx context.Context, create *FollowRequest) (*FollowRequest, error) {
+	return s.driver.CreateFollowRequest(ctx, create)
+}
+
+func (s *Store) ListFollowRequests(ctx context.Context, find *FindFollowRequest) ([]*FollowRequest, error) {
+	return s.driver.ListFollowRequests(ctx, find)
+}
+
+func (s *Store) GetFollowRequest(ctx context.Context, find *FindFollowRequest) (*FollowRequest, error) {
+	list, err := s.ListFollowRequests(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteFollowRequest(ctx context.Context, delete *DeleteFollowRequest) error {
+	return s.driver.DeleteFollowRequest(ctx, delete)
+}
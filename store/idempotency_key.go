@@ -0,0 +1,71 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// IdempotencyKeyTTL is how long an idempotency key is kept, the retries after it create again.
+const IdempotencyKeyTTL = 24 * time.Hour
+
+// IdempotencyKeyLease is how long an idempotency key is reserved for the request in progress,
+// the key still without the created name after it is reclaimed by the retries.
+const IdempotencyKeyLease = time.Minute
+
+// IdempotencyKey records a create request of a user by its idempotency key, so the retries of the request
+// return what the first one created instead of creating another one.
+type IdempotencyKey struct {
+	ID        int32
+	CreatorID int32
+	CreatedTs int64
+
+	Key string
+	// Method is the full name of the RPC the key is used with.
+	Method string
+	// Name is the name of the created resource, it's empty while the request is in progress.
+	Name string
+}
+
+type FindIdempotencyKey struct {
+	ID        *int32
+	CreatorID *int32
+	Key       *string
+	Method    *string
+}
+
+type UpdateIdempotencyKey struct {
+	ID   int32
+	Name *string
+}
+
+type DeleteIdempotencyKey struct {
+	ID              *int32
+	CreatedTsBefore *int64
+}
+
+func (s *Store) CreateIdempotencyKey(ctx context.Context, create *IdempotencyKey) (*IdempotencyKey, error) {
+	return s.driver.CreateIdempotencyKey(ctx, create)
+}
+
+func (s *Store) ListIdempotencyKeys(ctx context.Context, find *FindIdempotencyKey) ([]*IdempotencyKey, error) {
+	return s.driver.ListIdempotencyKeys(ctx, find)
+}
+
+func (s *Store) GetIdempotencyKey(ctx context.Context, find *FindIdempotencyKey) (*IdempotencyKey, error) {
+	list, err := s.ListIdempotencyKeys(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateIdempotencyKey(ctx context.Context, update *UpdateIdempotencyKey) error {
+	return s.driver.UpdateIdempotencyKey(ctx, update)
+}
+
+func (s *Store) DeleteIdempotencyKey(ctx context.Context, delete *DeleteIdempotencyKey) error {
+	return s.driver.DeleteIdempotencyKey(ctx, delete)
+}
@@ -41,6 +41,8 @@ type FindInbox struct {
 	// Pagination
 	Limit  *int
 	Offset *int
+	// Cursor is used for keyset pagination, inboxes are ordered by created_ts and id.
+	Cursor *Cursor
 }
 
 type DeleteInbox struct {
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/search"
 
 	storepb "github.com/usememos/memos/proto/gen/store"
 )
@@ -17,6 +18,8 @@ const (
 	Public Visibility = "PUBLIC"
 	// Protected is the PROTECTED visibility.
 	Protected Visibility = "PROTECTED"
+	// Followers is the FOLLOWERS visibility, the memo is visible to the followers of its creator.
+	Followers Visibility = "FOLLOWERS"
 	// Private is the PRIVATE visibility.
 	Private Visibility = "PRIVATE"
 )
@@ -27,6 +30,8 @@ func (v Visibility) String() string {
 		return "PUBLIC"
 	case Protected:
 		return "PROTECTED"
+	case Followers:
+		return "FOLLOWERS"
 	case Private:
 		return "PRIVATE"
 	}
@@ -44,12 +49,19 @@ type Memo struct {
 	CreatorID int32
 	CreatedTs int64
 	UpdatedTs int64
+	// TrashedTs is the time the memo was moved to the trash, 0 if it is not trashed.
+	TrashedTs int64
 
 	// Domain specific fields
 	Content    string
 	Visibility Visibility
 	Pinned     bool
 	Payload    *storepb.MemoPayload
+	// Version is bumped on every update of the memo.
+	Version int32
+	// ChangedTs is the time of the last change of the memo. Unlike UpdatedTs it is always set by the store,
+	// so the sync finds every change.
+	ChangedTs int64
 
 	// Composed fields
 	ParentID *int32
@@ -58,30 +70,48 @@ type Memo struct {
 type FindMemo struct {
 	ID  *int32
 	UID *string
+	// IDList is used to load many memos at once.
+	IDList []int32
 
 	// Standard fields
 	RowStatus       *RowStatus
 	CreatorID       *int32
 	IsFollow        bool
+	FollowTag       *string
 	CreatedTsAfter  *int64
 	CreatedTsBefore *int64
 	UpdatedTsAfter  *int64
 	UpdatedTsBefore *int64
+	ChangedTsAfter  *int64
+	TrashedTsBefore *int64
 
 	// Domain specific fields
-	ContentSearch   []string
-	VisibilityList  []Visibility
+	ContentSearch  []string
+	VisibilityList []Visibility
+	// ACLPrincipals extends VisibilityList with the memos shared with the principals by their ACL.
+	ACLPrincipals []string
+	// FollowerID extends VisibilityList with the FOLLOWERS memos of the users followed by the follower.
+	FollowerID *int32
+	// MuterID leaves the memos of the users muted by the muter out.
+	MuterID         *int32
 	PayloadFind     *FindMemoPayload
 	ExcludeContent  bool
 	ExcludeComments bool
-	Filter          *string
+	// ExcludeTrashedWithParent leaves out the comments moved to the trash together with their parent memo,
+	// which are restored and purged with it.
+	ExcludeTrashedWithParent bool
+	Filter                   *string
 
 	// Pagination
 	Limit  *int
 	Offset *int
+	// Cursor is used for keyset pagination, it must match the ordering.
+	Cursor *Cursor
 
 	// Ordering
 	OrderByUpdatedTs bool
+	// OrderByChangedTs orders the memos by their change time, it takes precedence over OrderByUpdatedTs.
+	OrderByChangedTs bool
 	OrderByPinned    bool
 	OrderByTimeAsc   bool
 }
@@ -101,28 +131,95 @@ type UpdateMemo struct {
 	UID        *string
 	CreatedTs  *int64
 	UpdatedTs  *int64
+	TrashedTs  *int64
 	RowStatus  *RowStatus
 	Content    *string
 	Visibility *Visibility
 	Pinned     *bool
 	Payload    *storepb.MemoPayload
+	// Version is the version of the memo the update is based on, if set the update fails with ErrMemoVersionMismatch
+	// when the memo has been updated since.
+	Version *int32
 }
 
+// ErrMemoVersionMismatch is returned by UpdateMemo when the memo has been updated since the version of the update.
+var ErrMemoVersionMismatch = errors.New("memo version mismatch")
+
 type DeleteMemo struct {
 	ID int32
 }
 
+const (
+	// SearchHighlightStart and SearchHighlightEnd wrap the matched terms in search snippets.
+	SearchHighlightStart = "<mark>"
+	SearchHighlightEnd   = "</mark>"
+)
+
+type SearchMemo struct {
+	// Query is the full-text query, it is split into terms with the search tokenizer.
+	Query string
+
+	// Standard fields
+	RowStatus *RowStatus
+	CreatorID *int32
+
+	// Domain specific fields
+	VisibilityList []Visibility
+	// ACLPrincipals, FollowerID and MuterID are the same as in FindMemo.
+	ACLPrincipals   []string
+	FollowerID      *int32
+	MuterID         *int32
+	ExcludeComments bool
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+type MemoSearchResult struct {
+	Memo *Memo
+	// Snippet is an HTML-escaped excerpt of the memo content with matched terms highlighted.
+	Snippet string
+	// Score is the relevance of the memo, higher is more relevant.
+	Score float64
+}
+
 func (s *Store) CreateMemo(ctx context.Context, create *Memo) (*Memo, error) {
 	if !util.UIDMatcher.MatchString(create.UID) {
 		return nil, errors.New("invalid uid")
 	}
-	return s.driver.CreateMemo(ctx, create)
+	var memo *Memo
+	if err := s.WithTx(ctx, func(tx *Store) error {
+		var err error
+		memo, err = tx.driver.CreateMemo(ctx, create)
+		if err != nil {
+			return err
+		}
+		return tx.setMemoTags(ctx, memo.ID, create.Payload.GetTags())
+	}); err != nil {
+		return nil, err
+	}
+	return memo, nil
 }
 
 func (s *Store) ListMemos(ctx context.Context, find *FindMemo) ([]*Memo, error) {
 	return s.driver.ListMemos(ctx, find)
 }
 
+// SearchMemos returns the memos matching the full-text query, ordered by relevance.
+func (s *Store) SearchMemos(ctx context.Context, find *SearchMemo) ([]*MemoSearchResult, error) {
+	list, err := s.driver.SearchMemos(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	// Snippets are built here rather than by the drivers so that all drivers highlight the same terms.
+	terms := search.Tokenize(find.Query)
+	for _, result := range list {
+		result.Snippet = buildSearchSnippet(result.Memo.Content, terms)
+	}
+	return list, nil
+}
+
 func (s *Store) GetMemo(ctx context.Context, find *FindMemo) (*Memo, error) {
 	list, err := s.ListMemos(ctx, find)
 	if err != nil {
@@ -140,9 +237,23 @@ func (s *Store) UpdateMemo(ctx context.Context, update *UpdateMemo) error {
 	if update.UID != nil && !util.UIDMatcher.MatchString(*update.UID) {
 		return errors.New("invalid uid")
 	}
-	return s.driver.UpdateMemo(ctx, update)
+	if update.Payload == nil {
+		return s.driver.UpdateMemo(ctx, update)
+	}
+	// Keep the memo tags in sync with the payload.
+	return s.WithTx(ctx, func(tx *Store) error {
+		if err := tx.driver.UpdateMemo(ctx, update); err != nil {
+			return err
+		}
+		return tx.setMemoTags(ctx, update.ID, update.Payload.GetTags())
+	})
 }
 
 func (s *Store) DeleteMemo(ctx context.Context, delete *DeleteMemo) error {
-	return s.driver.DeleteMemo(ctx, delete)
+	return s.WithTx(ctx, func(tx *Store) error {
+		if err := tx.driver.DeleteMemo(ctx, delete); err != nil {
+			return err
+		}
+		return tx.driver.DeleteMemoTag(ctx, &DeleteMemoTag{MemoID: &delete.ID})
+	})
 }
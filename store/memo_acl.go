@@ -0,0 +1,63 @@
+package store
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// MemoACLRole is the role granted to a principal by the ACL of a memo.
+type MemoACLRole string
+
+const (
+	// MemoACLRoleReader can read the memo and comment on it.
+	MemoACLRoleReader MemoACLRole = "READER"
+	// MemoACLRoleEditor can also update the content of the memo.
+	MemoACLRoleEditor MemoACLRole = "EDITOR"
+)
+
+func (r MemoACLRole) String() string {
+	return string(r)
+}
+
+// MemoACL is an entry of the access control list of a memo, which shares the memo with a principal
+// regardless of its visibility. The principal is the resource name of a user or a group, e.g. "users/1" or "groups/1".
+type MemoACL struct {
+	MemoID    int32
+	Principal string
+	Role      MemoACLRole
+}
+
+type FindMemoACL struct {
+	MemoID        *int32
+	MemoIDList    []int32
+	PrincipalList []string
+}
+
+type DeleteMemoACL struct {
+	MemoID    *int32
+	Principal *string
+}
+
+func (s *Store) ListMemoACLs(ctx context.Context, find *FindMemoACL) ([]*MemoACL, error) {
+	return s.driver.ListMemoACLs(ctx, find)
+}
+
+// SetMemoACL replaces the ACL of the memo.
+func (s *Store) SetMemoACL(ctx context.Context, memoID int32, acl []*MemoACL) error {
+	return s.WithTx(ctx, func(tx *Store) error {
+		if err := tx.driver.DeleteMemoACL(ctx, &DeleteMemoACL{MemoID: &memoID}); err != nil {
+			return errors.Wrap(err, "failed to delete memo acl")
+		}
+		for _, entry := range acl {
+			if err := tx.driver.UpsertMemoACL(ctx, &MemoACL{MemoID: memoID, Principal: entry.Principal, Role: entry.Role}); err != nil {
+				return errors.Wrap(err, "failed to upsert memo acl")
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Store) DeleteMemoACL(ctx context.Context, delete *DeleteMemoACL) error {
+	return s.driver.DeleteMemoACL(ctx, delete)
+}
@@ -23,6 +23,10 @@ type FindMemoRelation struct {
 	MemoID        *int32
 	RelatedMemoID *int32
 	Type          *MemoRelationType
+
+	// MemoIDList and RelatedMemoIDList are used to load the relations of many memos at once.
+	MemoIDList        []int32
+	RelatedMemoIDList []int32
 }
 
 type DeleteMemoRelation struct {
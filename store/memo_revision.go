@@ -0,0 +1,50 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoRevision is a snapshot of a memo's content and visibility.
+type MemoRevision struct {
+	ID        int32
+	MemoID    int32
+	CreatorID int32
+	CreatedTs int64
+
+	Content    string
+	Visibility Visibility
+}
+
+type FindMemoRevision struct {
+	ID     *int32
+	MemoID *int32
+}
+
+type DeleteMemoRevision struct {
+	ID     *int32
+	MemoID *int32
+}
+
+func (s *Store) CreateMemoRevision(ctx context.Context, create *MemoRevision) (*MemoRevision, error) {
+	return s.driver.CreateMemoRevision(ctx, create)
+}
+
+// ListMemoRevisions returns the revisions ordered from newest to oldest.
+func (s *Store) ListMemoRevisions(ctx context.Context, find *FindMemoRevision) ([]*MemoRevision, error) {
+	return s.driver.ListMemoRevisions(ctx, find)
+}
+
+func (s *Store) GetMemoRevision(ctx context.Context, find *FindMemoRevision) (*MemoRevision, error) {
+	list, err := s.ListMemoRevisions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteMemoRevision(ctx context.Context, delete *DeleteMemoRevision) error {
+	return s.driver.DeleteMemoRevision(ctx, delete)
+}
@@ -0,0 +1,59 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoShare is a link sharing a memo with anyone holding its secret token.
+type MemoShare struct {
+	ID        int32
+	MemoID    int32
+	CreatorID int32
+	CreatedTs int64
+
+	Token string
+	// ExpiresTs is the time the token expires, 0 means it never expires.
+	ExpiresTs int64
+	// MaxViews is the maximum number of views by the token, 0 means unlimited.
+	MaxViews  int32
+	ViewCount int32
+}
+
+type FindMemoShare struct {
+	ID     *int32
+	MemoID *int32
+	Token  *string
+}
+
+type DeleteMemoShare struct {
+	ID     *int32
+	MemoID *int32
+}
+
+func (s *Store) CreateMemoShare(ctx context.Context, create *MemoShare) (*MemoShare, error) {
+	return s.driver.CreateMemoShare(ctx, create)
+}
+
+func (s *Store) ListMemoShares(ctx context.Context, find *FindMemoShare) ([]*MemoShare, error) {
+	return s.driver.ListMemoShares(ctx, find)
+}
+
+func (s *Store) GetMemoShare(ctx context.Context, find *FindMemoShare) (*MemoShare, error) {
+	list, err := s.ListMemoShares(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// IncreaseMemoShareViewCount counts a view of the share, it returns false if the views are used up.
+func (s *Store) IncreaseMemoShareViewCount(ctx context.Context, id int32) (bool, error) {
+	return s.driver.IncreaseMemoShareViewCount(ctx, id)
+}
+
+func (s *Store) DeleteMemoShare(ctx context.Context, delete *DeleteMemoShare) error {
+	return s.driver.DeleteMemoShare(ctx, delete)
+}
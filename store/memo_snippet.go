@@ -0,0 +1,82 @@
+package store
+
+import (
+	"html"
+	"strings"
+	"unicode/utf8"
+)
+
+// snippetRadius is the number of characters kept around the first match in a search snippet.
+const snippetRadius = 64
+
+// buildSearchSnippet returns the content around the first matched term with all matched terms highlighted.
+// The content is HTML-escaped, so the highlight tags are the only markup in the snippet.
+func buildSearchSnippet(content string, terms []string) string {
+	matchContent := strings.ToLower(content)
+	// Lowercasing may change the byte length of some characters, the offsets are only valid if it does not.
+	foldCase := len(matchContent) == len(content)
+	if !foldCase {
+		matchContent = content
+	}
+
+	highlighted := make([]bool, len(content))
+	start, end := -1, -1
+	for _, term := range terms {
+		if foldCase {
+			term = strings.ToLower(term)
+		}
+		if term == "" {
+			continue
+		}
+		for offset := 0; ; {
+			index := strings.Index(matchContent[offset:], term)
+			if index < 0 {
+				break
+			}
+			index += offset
+			for i := index; i < index+len(term); i++ {
+				highlighted[i] = true
+			}
+			if start < 0 || index < start {
+				start, end = index, index+len(term)
+			}
+			offset = index + len(term)
+		}
+	}
+	if start < 0 {
+		start, end = 0, 0
+	}
+
+	// Expand the window by runes so multi-byte characters are not cut.
+	for i := 0; i < snippetRadius && start > 0; i++ {
+		_, size := utf8.DecodeLastRuneInString(content[:start])
+		start -= size
+	}
+	for i := 0; i < snippetRadius && end < len(content); i++ {
+		_, size := utf8.DecodeRuneInString(content[end:])
+		end += size
+	}
+
+	var builder strings.Builder
+	if start > 0 {
+		builder.WriteString("...")
+	}
+	for i := start; i < end; {
+		j := i
+		for j < end && highlighted[j] == highlighted[i] {
+			j++
+		}
+		if highlighted[i] {
+			builder.WriteString(SearchHighlightStart)
+			builder.WriteString(html.EscapeString(content[i:j]))
+			builder.WriteString(SearchHighlightEnd)
+		} else {
+			builder.WriteString(html.EscapeString(content[i:j]))
+		}
+		i = j
+	}
+	if end < len(content) {
+		builder.WriteString("...")
+	}
+	return builder.String()
+}
@@ -0,0 +1,61 @@
+package store
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// MemoTag is a tag of a memo, it mirrors the tags in the memo payload for indexed queries.
+type MemoTag struct {
+	MemoID int32
+	Tag    string
+}
+
+type FindMemoTag struct {
+	MemoID     *int32
+	MemoIDList []int32
+	Tag        *string
+}
+
+type DeleteMemoTag struct {
+	MemoID *int32
+	Tag    *string
+}
+
+type MemoTagCount struct {
+	CreatorID int32
+	Tag       string
+	Count     int32
+	// LastUsedTs is the latest update time of the memos with the tag.
+	LastUsedTs int64
+}
+
+type FindMemoTagCount struct {
+	CreatorID       *int32
+	RowStatus       *RowStatus
+	VisibilityList  []Visibility
+	ExcludeComments bool
+}
+
+func (s *Store) ListMemoTags(ctx context.Context, find *FindMemoTag) ([]*MemoTag, error) {
+	return s.driver.ListMemoTags(ctx, find)
+}
+
+// CountMemoTags returns the number of memos with each tag, grouped by the memo creator.
+func (s *Store) CountMemoTags(ctx context.Context, find *FindMemoTagCount) ([]*MemoTagCount, error) {
+	return s.driver.CountMemoTags(ctx, find)
+}
+
+// setMemoTags replaces the tags of the memo.
+func (s *Store) setMemoTags(ctx context.Context, memoID int32, tags []string) error {
+	if err := s.driver.DeleteMemoTag(ctx, &DeleteMemoTag{MemoID: &memoID}); err != nil {
+		return errors.Wrap(err, "failed to delete memo tags")
+	}
+	for _, tag := range tags {
+		if err := s.driver.UpsertMemoTag(ctx, &MemoTag{MemoID: memoID, Tag: tag}); err != nil {
+			return errors.Wrap(err, "failed to upsert memo tag")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TrashMemo moves the memo and its comments to the trash, and records their tombstones.
+func (s *Store) TrashMemo(ctx context.Context, memo *Memo) error {
+	return s.WithTx(ctx, func(tx *Store) error {
+		trashedTs := time.Now().Unix()
+		if err := tx.updateMemoTrashStatus(ctx, memo.ID, Trashed, trashedTs); err != nil {
+			return err
+		}
+		if err := tx.CreateMemoTombstone(ctx, memo, TombstoneReasonDeleted); err != nil {
+			return err
+		}
+
+		comments, err := tx.listMemoComments(ctx, memo.ID)
+		if err != nil {
+			return err
+		}
+		for _, comment := range comments {
+			if comment.RowStatus == Trashed {
+				continue
+			}
+			// Comments share the trashed_ts of the memo so they can be restored together.
+			if err := tx.updateMemoTrashStatus(ctx, comment.ID, Trashed, trashedTs); err != nil {
+				return err
+			}
+			if err := tx.CreateMemoTombstone(ctx, comment, TombstoneReasonDeleted); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// RestoreMemo restores the memo and the comments trashed together with it.
+func (s *Store) RestoreMemo(ctx context.Context, memo *Memo) error {
+	return s.WithTx(ctx, func(tx *Store) error {
+		if err := tx.updateMemoTrashStatus(ctx, memo.ID, Normal, 0); err != nil {
+			return err
+		}
+
+		comments, err := tx.listMemoComments(ctx, memo.ID)
+		if err != nil {
+			return err
+		}
+		for _, comment := range comments {
+			if comment.RowStatus != Trashed || comment.TrashedTs != memo.TrashedTs {
+				continue
+			}
+			if err := tx.updateMemoTrashStatus(ctx, comment.ID, Normal, 0); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// PurgeMemo permanently deletes the memo with its relations, revisions, resources and comments.
+func (s *Store) PurgeMemo(ctx context.Context, memo *Memo) error {
+	return s.WithTx(ctx, func(tx *Store) error {
+		comments, err := tx.listMemoComments(ctx, memo.ID)
+		if err != nil {
+			return err
+		}
+		for _, comment := range comments {
+			if err := tx.PurgeMemo(ctx, comment); err != nil {
+				return errors.Wrap(err, "failed to purge memo comment")
+			}
+		}
+
+		if err := tx.DeleteMemo(ctx, &DeleteMemo{ID: memo.ID}); err != nil {
+			return errors.Wrap(err, "failed to delete memo")
+		}
+		if err := tx.DeleteMemoRelation(ctx, &DeleteMemoRelation{MemoID: &memo.ID}); err != nil {
+			return errors.Wrap(err, "failed to delete memo relations")
+		}
+		referenceType := MemoRelationReference
+		if err := tx.DeleteMemoRelation(ctx, &DeleteMemoRelation{RelatedMemoID: &memo.ID, Type: &referenceType}); err != nil {
+			return errors.Wrap(err, "failed to delete memo references")
+		}
+		if err := tx.DeleteMemoRevision(ctx, &DeleteMemoRevision{MemoID: &memo.ID}); err != nil {
+			return errors.Wrap(err, "failed to delete memo revisions")
+		}
+		if err := tx.DeleteMemoShare(ctx, &DeleteMemoShare{MemoID: &memo.ID}); err != nil {
+			return errors.Wrap(err, "failed to delete memo shares")
+		}
+		if err := tx.DeleteMemoACL(ctx, &DeleteMemoACL{MemoID: &memo.ID}); err != nil {
+			return errors.Wrap(err, "failed to delete memo acl")
+		}
+		// Resources are deleted last as removing their blobs cannot be rolled back.
+		resources, err := tx.ListResources(ctx, &FindResource{MemoID: &memo.ID})
+		if err != nil {
+			return errors.Wrap(err, "failed to list resources")
+		}
+		for _, resource := range resources {
+			if err := tx.DeleteResource(ctx, &DeleteResource{ID: resource.ID}); err != nil {
+				return errors.Wrap(err, "failed to delete resource")
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Store) updateMemoTrashStatus(ctx context.Context, memoID int32, rowStatus RowStatus, trashedTs int64) error {
+	update := &UpdateMemo{
+		ID:        memoID,
+		RowStatus: &rowStatus,
+		TrashedTs: &trashedTs,
+	}
+	if rowStatus == Normal {
+		// The restored memo is synced again as an updated one.
+		updatedTs := time.Now().Unix()
+		update.UpdatedTs = &updatedTs
+	}
+	if err := s.UpdateMemo(ctx, update); err != nil {
+		return errors.Wrap(err, "failed to update memo")
+	}
+	return nil
+}
+
+// CreateMemoTombstone records the tombstone of the memo removed for the reason.
+func (s *Store) CreateMemoTombstone(ctx context.Context, memo *Memo, reason TombstoneReason) error {
+	if _, err := s.CreateTombstone(ctx, &Tombstone{
+		CreatorID: memo.CreatorID,
+		Type:      TombstoneTypeMemo,
+		UID:       memo.UID,
+		Reason:    reason,
+	}); err != nil {
+		return errors.Wrap(err, "failed to create memo tombstone")
+	}
+	return nil
+}
+
+func (s *Store) listMemoComments(ctx context.Context, memoID int32) ([]*Memo, error) {
+	commentType := MemoRelationComment
+	relations, err := s.ListMemoRelations(ctx, &FindMemoRelation{RelatedMemoID: &memoID, Type: &commentType})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memo comments")
+	}
+	comments := []*Memo{}
+	for _, relation := range relations {
+		comment, err := s.GetMemo(ctx, &FindMemo{ID: &relation.MemoID, ExcludeContent: true})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get memo comment")
+		}
+		if comment != nil {
+			comments = append(comments, comment)
+		}
+	}
+	return comments, nil
+}
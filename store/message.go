@@ -0,0 +1,52 @@
+package store
+
+import (
+	"context"
+)
+
+// Message is a chat message of a channel.
+type Message struct {
+	ID        int32
+	ChannelID int32
+	CreatorID int32
+	CreatedTs int64
+
+	Content string
+	// MemoID is the memo shared by the message, if any.
+	MemoID *int32
+}
+
+type FindMessage struct {
+	ID        *int32
+	ChannelID *int32
+
+	// Pagination, the messages are listed from the newest to the oldest.
+	Limit *int
+	// Cursor is the last message of the previous page, only its id is used.
+	Cursor *Cursor
+}
+
+func (s *Store) CreateMessage(ctx context.Context, create *Message) (*Message, error) {
+	return s.driver.CreateMessage(ctx, create)
+}
+
+func (s *Store) ListMessages(ctx context.Context, find *FindMessage) ([]*Message, error) {
+	return s.driver.ListMessages(ctx, find)
+}
+
+func (s *Store) GetMessage(ctx context.Context, find *FindMessage) (*Message, error) {
+	list, err := s.ListMessages(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// CountUnreadMessages returns the number of messages the user has not read in each of the user's channels.
+// The messages of the user are never unread, and the channels without unread messages are omitted.
+func (s *Store) CountUnreadMessages(ctx context.Context, userID int32) (map[int32]int32, error) {
+	return s.driver.CountUnreadMessages(ctx, userID)
+}
@@ -0,0 +1,10 @@
+-- memo_revision
+CREATE TABLE `memo_revision` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `content` TEXT NOT NULL,
+  `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
+  INDEX `idx_memo_revision_memo_id` (`memo_id`)
+);
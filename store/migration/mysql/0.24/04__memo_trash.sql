@@ -0,0 +1 @@
+ALTER TABLE `memo` ADD COLUMN `trashed_ts` BIGINT NOT NULL DEFAULT 0;
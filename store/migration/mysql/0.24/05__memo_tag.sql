@@ -0,0 +1,11 @@
+-- memo_tag
+CREATE TABLE `memo_tag` (
+  `memo_id` INT NOT NULL,
+  `tag` VARCHAR(256) NOT NULL,
+  UNIQUE(`memo_id`,`tag`),
+  INDEX `idx_memo_tag_tag` (`tag`)
+);
+
+INSERT IGNORE INTO `memo_tag` (`memo_id`, `tag`)
+SELECT `memo`.`id`, `tags`.`tag`
+FROM `memo`, JSON_TABLE(`memo`.`payload`, '$.tags[*]' COLUMNS (`tag` VARCHAR(256) PATH '$')) AS `tags`;
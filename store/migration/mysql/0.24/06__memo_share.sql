@@ -0,0 +1,12 @@
+-- memo_share
+CREATE TABLE `memo_share` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `token` VARCHAR(256) NOT NULL UNIQUE,
+  `expires_ts` BIGINT NOT NULL DEFAULT 0,
+  `max_views` INT NOT NULL DEFAULT 0,
+  `view_count` INT NOT NULL DEFAULT 0,
+  INDEX `idx_memo_share_memo_id` (`memo_id`)
+);
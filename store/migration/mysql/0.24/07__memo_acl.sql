@@ -0,0 +1,8 @@
+-- memo_acl
+CREATE TABLE `memo_acl` (
+  `memo_id` INT NOT NULL,
+  `principal` VARCHAR(256) NOT NULL,
+  `role` VARCHAR(256) NOT NULL DEFAULT 'READER',
+  UNIQUE(`memo_id`,`principal`),
+  INDEX `idx_memo_acl_principal` (`principal`)
+);
@@ -0,0 +1,15 @@
+-- Index the updated time for the delta sync.
+CREATE INDEX `idx_memo_creator_id_updated_ts` ON `memo` (`creator_id`, `updated_ts`);
+
+CREATE INDEX `idx_resource_creator_id_updated_ts` ON `resource` (`creator_id`, `updated_ts`);
+
+-- tombstone
+CREATE TABLE `tombstone` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `type` VARCHAR(256) NOT NULL,
+  `uid` VARCHAR(256) NOT NULL,
+  `reason` VARCHAR(256) NOT NULL,
+  INDEX `idx_tombstone_creator_id_created_ts` (`creator_id`, `created_ts`)
+);
@@ -0,0 +1,11 @@
+-- idempotency_key
+CREATE TABLE `idempotency_key` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `key` VARCHAR(256) NOT NULL,
+  `method` VARCHAR(256) NOT NULL,
+  `name` VARCHAR(256) NOT NULL DEFAULT '',
+  UNIQUE(`creator_id`, `method`, `key`),
+  INDEX `idx_idempotency_key_created_ts` (`created_ts`)
+);
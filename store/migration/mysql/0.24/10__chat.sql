@@ -0,0 +1,30 @@
+-- channel
+CREATE TABLE `channel` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `type` VARCHAR(256) NOT NULL,
+  `name` VARCHAR(256) NOT NULL DEFAULT ''
+);
+
+-- channel_member
+CREATE TABLE `channel_member` (
+  `channel_id` INT NOT NULL,
+  `user_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `last_read_message_id` INT NOT NULL DEFAULT 0,
+  UNIQUE(`channel_id`, `user_id`),
+  INDEX `idx_channel_member_user_id` (`user_id`)
+);
+
+-- message
+CREATE TABLE `message` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `channel_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `content` TEXT NOT NULL,
+  `memo_id` INT,
+  INDEX `idx_message_channel_id` (`channel_id`, `id`)
+);
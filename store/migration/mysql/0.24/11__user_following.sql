@@ -0,0 +1,9 @@
+-- user_following
+CREATE TABLE IF NOT EXISTS `user_following` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `user_id` INT NOT NULL,
+  `following_user_id` INT NOT NULL,
+  `tag` VARCHAR(256) NOT NULL DEFAULT 'default',
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE(`user_id`, `following_user_id`)
+);
@@ -0,0 +1,3 @@
+ALTER TABLE `memo` ADD CONSTRAINT `memo_visibility_check` CHECK (`visibility` IN ('PUBLIC', 'PROTECTED', 'FOLLOWERS', 'PRIVATE'));
+
+ALTER TABLE `memo_revision` ADD CONSTRAINT `memo_revision_visibility_check` CHECK (`visibility` IN ('PUBLIC', 'PROTECTED', 'FOLLOWERS', 'PRIVATE'));
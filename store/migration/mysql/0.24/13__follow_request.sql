@@ -0,0 +1,9 @@
+-- follow_request
+CREATE TABLE `follow_request` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `requester_id` INT NOT NULL,
+  `user_id` INT NOT NULL,
+  UNIQUE(`requester_id`, `user_id`),
+  INDEX `idx_follow_request_user_id` (`user_id`)
+);
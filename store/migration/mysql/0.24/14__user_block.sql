@@ -0,0 +1,10 @@
+-- user_block
+CREATE TABLE `user_block` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `user_id` INT NOT NULL,
+  `blocked_user_id` INT NOT NULL,
+  `type` VARCHAR(256) NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE(`user_id`, `blocked_user_id`, `type`),
+  INDEX `idx_user_block_blocked_user_id` (`blocked_user_id`)
+);
@@ -0,0 +1,7 @@
+ALTER TABLE `user` ADD COLUMN `location` VARCHAR(256) NOT NULL DEFAULT '';
+
+ALTER TABLE `user` ADD COLUMN `industry` VARCHAR(256) NOT NULL DEFAULT '';
+
+ALTER TABLE `user` ADD COLUMN `occupation` VARCHAR(256) NOT NULL DEFAULT '';
+
+ALTER TABLE `user` ADD COLUMN `university` VARCHAR(256) NOT NULL DEFAULT '';
@@ -0,0 +1,4 @@
+-- The ngram parser indexes substrings, so text without word boundaries such as CJK is searchable.
+ALTER TABLE `memo` DROP INDEX `idx_memo_content`;
+
+ALTER TABLE `memo` ADD FULLTEXT INDEX `idx_memo_content` (`content`) WITH PARSER ngram;
@@ -0,0 +1,16 @@
+-- user_group
+CREATE TABLE `user_group` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `name` VARCHAR(256) NOT NULL
+);
+
+-- user_group_member
+CREATE TABLE `user_group_member` (
+  `group_id` INT NOT NULL,
+  `user_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE(`group_id`, `user_id`),
+  INDEX `idx_user_group_member_user_id` (`user_id`)
+);
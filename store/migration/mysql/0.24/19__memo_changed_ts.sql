@@ -0,0 +1,7 @@
+-- The change time of a memo is set by the server on every change, unlike the update time the clients can set,
+-- so the sync finds every change.
+ALTER TABLE `memo` ADD COLUMN `changed_ts` BIGINT NOT NULL DEFAULT 0;
+
+UPDATE `memo` SET `changed_ts` = UNIX_TIMESTAMP(`updated_ts`);
+
+CREATE INDEX `idx_memo_creator_id_changed_ts` ON `memo` (`creator_id`, `changed_ts`);
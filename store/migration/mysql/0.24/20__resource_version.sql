@@ -0,0 +1,2 @@
+-- The version of a resource is bumped on every update, the updates based on a stale version are rejected.
+ALTER TABLE `resource` ADD COLUMN `version` INT NOT NULL DEFAULT 0;
@@ -23,7 +23,11 @@ CREATE TABLE `user` (
   `nickname` VARCHAR(256) NOT NULL DEFAULT '',
   `password_hash` VARCHAR(256) NOT NULL,
   `avatar_url` LONGTEXT NOT NULL,
-  `description` VARCHAR(256) NOT NULL DEFAULT ''
+  `description` VARCHAR(256) NOT NULL DEFAULT '',
+  `location` VARCHAR(256) NOT NULL DEFAULT '',
+  `industry` VARCHAR(256) NOT NULL DEFAULT '',
+  `occupation` VARCHAR(256) NOT NULL DEFAULT '',
+  `university` VARCHAR(256) NOT NULL DEFAULT ''
 );
 
 -- user_following
@@ -55,11 +59,28 @@ CREATE TABLE `memo` (
   `creator_id` INT NOT NULL,
   `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
   `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `trashed_ts` BIGINT NOT NULL DEFAULT 0,
   `row_status` VARCHAR(256) NOT NULL DEFAULT 'NORMAL',
   `content` TEXT NOT NULL,
-  `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
+  `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE' CHECK (`visibility` IN ('PUBLIC', 'PROTECTED', 'FOLLOWERS', 'PRIVATE')),
   `pinned` BOOLEAN NOT NULL DEFAULT FALSE,
-  `payload` JSON NOT NULL
+  `payload` JSON NOT NULL,
+  `version` INT NOT NULL DEFAULT 0,
+  `changed_ts` BIGINT NOT NULL DEFAULT 0,
+  FULLTEXT INDEX `idx_memo_content` (`content`) WITH PARSER ngram,
+  INDEX `idx_memo_creator_id_updated_ts` (`creator_id`, `updated_ts`),
+  INDEX `idx_memo_creator_id_changed_ts` (`creator_id`, `changed_ts`)
+);
+
+-- memo_revision
+CREATE TABLE `memo_revision` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `content` TEXT NOT NULL,
+  `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE' CHECK (`visibility` IN ('PUBLIC', 'PROTECTED', 'FOLLOWERS', 'PRIVATE')),
+  INDEX `idx_memo_revision_memo_id` (`memo_id`)
 );
 
 -- memo_organizer
@@ -78,6 +99,47 @@ CREATE TABLE `memo_relation` (
   UNIQUE(`memo_id`,`related_memo_id`,`type`)
 );
 
+-- memo_tag
+CREATE TABLE `memo_tag` (
+  `memo_id` INT NOT NULL,
+  `tag` VARCHAR(256) NOT NULL,
+  UNIQUE(`memo_id`,`tag`),
+  INDEX `idx_memo_tag_tag` (`tag`)
+);
+
+-- memo_share
+CREATE TABLE `memo_share` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `token` VARCHAR(256) NOT NULL UNIQUE,
+  `expires_ts` BIGINT NOT NULL DEFAULT 0,
+  `max_views` INT NOT NULL DEFAULT 0,
+  `view_count` INT NOT NULL DEFAULT 0,
+  INDEX `idx_memo_share_memo_id` (`memo_id`)
+);
+
+-- memo_acl
+CREATE TABLE `memo_acl` (
+  `memo_id` INT NOT NULL,
+  `principal` VARCHAR(256) NOT NULL,
+  `role` VARCHAR(256) NOT NULL DEFAULT 'READER',
+  UNIQUE(`memo_id`,`principal`),
+  INDEX `idx_memo_acl_principal` (`principal`)
+);
+
+-- tombstone
+CREATE TABLE `tombstone` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `type` VARCHAR(256) NOT NULL,
+  `uid` VARCHAR(256) NOT NULL,
+  `reason` VARCHAR(256) NOT NULL,
+  INDEX `idx_tombstone_creator_id_created_ts` (`creator_id`, `created_ts`)
+);
+
 -- resource
 CREATE TABLE `resource` (
   `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
@@ -92,7 +154,9 @@ CREATE TABLE `resource` (
   `memo_id` INT DEFAULT NULL,
   `storage_type` VARCHAR(256) NOT NULL DEFAULT '',
   `reference` VARCHAR(256) NOT NULL DEFAULT '',
-  `payload` TEXT NOT NULL
+  `payload` TEXT NOT NULL,
+  `version` INT NOT NULL DEFAULT 0,
+  INDEX `idx_resource_creator_id_updated_ts` (`creator_id`, `updated_ts`)
 );
 
 -- activity
@@ -144,3 +208,84 @@ CREATE TABLE `reaction` (
   `reaction_type` VARCHAR(256) NOT NULL,
   UNIQUE(`creator_id`,`content_id`,`reaction_type`)  
 );
+
+-- idempotency_key
+CREATE TABLE `idempotency_key` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `key` VARCHAR(256) NOT NULL,
+  `method` VARCHAR(256) NOT NULL,
+  `name` VARCHAR(256) NOT NULL DEFAULT '',
+  UNIQUE(`creator_id`, `method`, `key`),
+  INDEX `idx_idempotency_key_created_ts` (`created_ts`)
+);
+
+-- channel
+CREATE TABLE `channel` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `type` VARCHAR(256) NOT NULL,
+  `name` VARCHAR(256) NOT NULL DEFAULT ''
+);
+
+-- channel_member
+CREATE TABLE `channel_member` (
+  `channel_id` INT NOT NULL,
+  `user_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `last_read_message_id` INT NOT NULL DEFAULT 0,
+  UNIQUE(`channel_id`, `user_id`),
+  INDEX `idx_channel_member_user_id` (`user_id`)
+);
+
+-- message
+CREATE TABLE `message` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `channel_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `content` TEXT NOT NULL,
+  `memo_id` INT,
+  INDEX `idx_message_channel_id` (`channel_id`, `id`)
+);
+
+-- follow_request
+CREATE TABLE `follow_request` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `requester_id` INT NOT NULL,
+  `user_id` INT NOT NULL,
+  UNIQUE(`requester_id`, `user_id`),
+  INDEX `idx_follow_request_user_id` (`user_id`)
+);
+
+-- user_block
+CREATE TABLE `user_block` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `user_id` INT NOT NULL,
+  `blocked_user_id` INT NOT NULL,
+  `type` VARCHAR(256) NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE(`user_id`, `blocked_user_id`, `type`),
+  INDEX `idx_user_block_blocked_user_id` (`blocked_user_id`)
+);
+
+-- user_group
+CREATE TABLE `user_group` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `name` VARCHAR(256) NOT NULL
+);
+
+-- user_group_member
+CREATE TABLE `user_group_member` (
+  `group_id` INT NOT NULL,
+  `user_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE(`group_id`, `user_id`),
+  INDEX `idx_user_group_member_user_id` (`user_id`)
+);
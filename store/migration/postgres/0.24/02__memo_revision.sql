@@ -0,0 +1,11 @@
+-- memo_revision
+CREATE TABLE memo_revision (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  content TEXT NOT NULL,
+  visibility TEXT NOT NULL DEFAULT 'PRIVATE'
+);
+
+CREATE INDEX idx_memo_revision_memo_id ON memo_revision (memo_id);
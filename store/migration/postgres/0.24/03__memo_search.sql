@@ -0,0 +1,3 @@
+ALTER TABLE memo ADD COLUMN content_search TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', content)) STORED;
+
+CREATE INDEX idx_memo_content_search ON memo USING GIN (content_search);
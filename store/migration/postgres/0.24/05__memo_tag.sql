@@ -0,0 +1,15 @@
+-- memo_tag
+CREATE TABLE memo_tag (
+  memo_id INTEGER NOT NULL,
+  tag TEXT NOT NULL,
+  UNIQUE(memo_id, tag)
+);
+
+CREATE INDEX idx_memo_tag_tag ON memo_tag (tag);
+
+INSERT INTO memo_tag (memo_id, tag)
+SELECT memo.id, tags.tag
+FROM memo, jsonb_array_elements_text(
+  CASE WHEN jsonb_typeof(memo.payload->'tags') = 'array' THEN memo.payload->'tags' ELSE '[]'::jsonb END
+) AS tags(tag)
+ON CONFLICT DO NOTHING;
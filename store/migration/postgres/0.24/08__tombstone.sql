@@ -0,0 +1,16 @@
+-- Index the updated time for the delta sync.
+CREATE INDEX idx_memo_creator_id_updated_ts ON memo (creator_id, updated_ts);
+
+CREATE INDEX idx_resource_creator_id_updated_ts ON resource (creator_id, updated_ts);
+
+-- tombstone
+CREATE TABLE tombstone (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  type TEXT NOT NULL,
+  uid TEXT NOT NULL,
+  reason TEXT NOT NULL
+);
+
+CREATE INDEX idx_tombstone_creator_id_created_ts ON tombstone (creator_id, created_ts);
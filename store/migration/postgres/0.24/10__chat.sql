@@ -0,0 +1,32 @@
+-- channel
+CREATE TABLE channel (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  type TEXT NOT NULL,
+  name TEXT NOT NULL DEFAULT ''
+);
+
+-- channel_member
+CREATE TABLE channel_member (
+  channel_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  last_read_message_id INTEGER NOT NULL DEFAULT 0,
+  UNIQUE(channel_id, user_id)
+);
+
+CREATE INDEX idx_channel_member_user_id ON channel_member (user_id);
+
+-- message
+CREATE TABLE message (
+  id SERIAL PRIMARY KEY,
+  channel_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  content TEXT NOT NULL DEFAULT '',
+  memo_id INTEGER
+);
+
+CREATE INDEX idx_message_channel_id ON message (channel_id, id);
@@ -0,0 +1,9 @@
+-- user_following
+CREATE TABLE IF NOT EXISTS user_following (
+  id SERIAL PRIMARY KEY,
+  user_id INTEGER NOT NULL,
+  following_user_id INTEGER NOT NULL,
+  tag TEXT NOT NULL DEFAULT 'default',
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(user_id, following_user_id)
+);
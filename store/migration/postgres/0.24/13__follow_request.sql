@@ -0,0 +1,10 @@
+-- follow_request
+CREATE TABLE follow_request (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  requester_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  UNIQUE(requester_id, user_id)
+);
+
+CREATE INDEX idx_follow_request_user_id ON follow_request (user_id);
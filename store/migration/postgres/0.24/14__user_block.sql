@@ -0,0 +1,11 @@
+-- user_block
+CREATE TABLE user_block (
+  id SERIAL PRIMARY KEY,
+  user_id INTEGER NOT NULL,
+  blocked_user_id INTEGER NOT NULL,
+  type TEXT NOT NULL CHECK (type IN ('BLOCK', 'MUTE')),
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(user_id, blocked_user_id, type)
+);
+
+CREATE INDEX idx_user_block_blocked_user_id ON user_block (blocked_user_id);
@@ -0,0 +1,7 @@
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS location TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS industry TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS occupation TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS university TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,8 @@
+-- The trigram index matches substrings, so text without word boundaries such as CJK is searchable.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+DROP INDEX IF EXISTS idx_memo_content_search;
+
+ALTER TABLE memo DROP COLUMN IF EXISTS content_search;
+
+CREATE INDEX idx_memo_content_trgm ON memo USING GIN (content gin_trgm_ops);
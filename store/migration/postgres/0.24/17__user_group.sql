@@ -0,0 +1,17 @@
+-- user_group
+CREATE TABLE user_group (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  name TEXT NOT NULL
+);
+
+-- user_group_member
+CREATE TABLE user_group_member (
+  group_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(group_id, user_id)
+);
+
+CREATE INDEX idx_user_group_member_user_id ON user_group_member (user_id);
@@ -0,0 +1,2 @@
+-- The version of a memo is bumped on every update, the updates based on a stale version are rejected.
+ALTER TABLE memo ADD COLUMN version INTEGER NOT NULL DEFAULT 0;
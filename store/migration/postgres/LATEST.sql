@@ -1,3 +1,6 @@
+-- pg_trgm
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
 -- migration_history
 CREATE TABLE migration_history (
   version TEXT NOT NULL PRIMARY KEY,
@@ -23,7 +26,21 @@ CREATE TABLE "user" (
   nickname TEXT NOT NULL DEFAULT '',
   password_hash TEXT NOT NULL,
   avatar_url TEXT NOT NULL,
-  description TEXT NOT NULL DEFAULT ''
+  description TEXT NOT NULL DEFAULT '',
+  location TEXT NOT NULL DEFAULT '',
+  industry TEXT NOT NULL DEFAULT '',
+  occupation TEXT NOT NULL DEFAULT '',
+  university TEXT NOT NULL DEFAULT ''
+);
+
+-- user_following
+CREATE TABLE user_following (
+  id SERIAL PRIMARY KEY,
+  user_id INTEGER NOT NULL,
+  following_user_id INTEGER NOT NULL,
+  tag TEXT NOT NULL DEFAULT 'default',
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(user_id, following_user_id)
 );
 
 -- user_setting
@@ -41,13 +58,34 @@ CREATE TABLE memo (
   creator_id INTEGER NOT NULL,
   created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
   updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  trashed_ts BIGINT NOT NULL DEFAULT 0,
   row_status TEXT NOT NULL DEFAULT 'NORMAL',
   content TEXT NOT NULL,
-  visibility TEXT NOT NULL DEFAULT 'PRIVATE',
+  visibility TEXT NOT NULL DEFAULT 'PRIVATE' CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'FOLLOWERS', 'PRIVATE')),
   pinned BOOLEAN NOT NULL DEFAULT FALSE,
-  payload JSONB NOT NULL DEFAULT '{}'
+  payload JSONB NOT NULL DEFAULT '{}',
+  version INTEGER NOT NULL DEFAULT 0,
+  changed_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_memo_content_trgm ON memo USING GIN (content gin_trgm_ops);
+
+CREATE INDEX idx_memo_creator_id_updated_ts ON memo (creator_id, updated_ts);
+
+CREATE INDEX idx_memo_creator_id_changed_ts ON memo (creator_id, changed_ts);
+
+-- memo_revision
+CREATE TABLE memo_revision (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  content TEXT NOT NULL,
+  visibility TEXT NOT NULL DEFAULT 'PRIVATE' CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'FOLLOWERS', 'PRIVATE'))
 );
 
+CREATE INDEX idx_memo_revision_memo_id ON memo_revision (memo_id);
+
 -- memo_organizer
 CREATE TABLE memo_organizer (
   memo_id INTEGER NOT NULL,
@@ -64,6 +102,51 @@ CREATE TABLE memo_relation (
   UNIQUE(memo_id, related_memo_id, type)
 );
 
+-- memo_tag
+CREATE TABLE memo_tag (
+  memo_id INTEGER NOT NULL,
+  tag TEXT NOT NULL,
+  UNIQUE(memo_id, tag)
+);
+
+CREATE INDEX idx_memo_tag_tag ON memo_tag (tag);
+
+-- memo_share
+CREATE TABLE memo_share (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  token TEXT NOT NULL UNIQUE,
+  expires_ts BIGINT NOT NULL DEFAULT 0,
+  max_views INTEGER NOT NULL DEFAULT 0,
+  view_count INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_memo_share_memo_id ON memo_share (memo_id);
+
+-- memo_acl
+CREATE TABLE memo_acl (
+  memo_id INTEGER NOT NULL,
+  principal TEXT NOT NULL,
+  role TEXT NOT NULL DEFAULT 'READER',
+  UNIQUE(memo_id, principal)
+);
+
+CREATE INDEX idx_memo_acl_principal ON memo_acl (principal);
+
+-- tombstone
+CREATE TABLE tombstone (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  type TEXT NOT NULL,
+  uid TEXT NOT NULL,
+  reason TEXT NOT NULL
+);
+
+CREATE INDEX idx_tombstone_creator_id_created_ts ON tombstone (creator_id, created_ts);
+
 -- resource
 CREATE TABLE resource (
   id SERIAL PRIMARY KEY,
@@ -78,9 +161,12 @@ CREATE TABLE resource (
   memo_id INTEGER DEFAULT NULL,
   storage_type TEXT NOT NULL DEFAULT '',
   reference TEXT NOT NULL DEFAULT '',
-  payload TEXT NOT NULL DEFAULT '{}'
+  payload TEXT NOT NULL DEFAULT '{}',
+  version INTEGER NOT NULL DEFAULT 0
 );
 
+CREATE INDEX idx_resource_creator_id_updated_ts ON resource (creator_id, updated_ts);
+
 -- activity
 CREATE TABLE activity (
   id SERIAL PRIMARY KEY,
@@ -130,3 +216,90 @@ CREATE TABLE reaction (
   reaction_type TEXT NOT NULL,
   UNIQUE(creator_id, content_id, reaction_type)
 );
+
+-- idempotency_key
+CREATE TABLE idempotency_key (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  key TEXT NOT NULL,
+  method TEXT NOT NULL,
+  name TEXT NOT NULL DEFAULT '',
+  UNIQUE(creator_id, method, key)
+);
+
+CREATE INDEX idx_idempotency_key_created_ts ON idempotency_key (created_ts);
+
+-- channel
+CREATE TABLE channel (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  type TEXT NOT NULL,
+  name TEXT NOT NULL DEFAULT ''
+);
+
+-- channel_member
+CREATE TABLE channel_member (
+  channel_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  last_read_message_id INTEGER NOT NULL DEFAULT 0,
+  UNIQUE(channel_id, user_id)
+);
+
+CREATE INDEX idx_channel_member_user_id ON channel_member (user_id);
+
+-- message
+CREATE TABLE message (
+  id SERIAL PRIMARY KEY,
+  channel_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  content TEXT NOT NULL DEFAULT '',
+  memo_id INTEGER
+);
+
+CREATE INDEX idx_message_channel_id ON message (channel_id, id);
+
+-- follow_request
+CREATE TABLE follow_request (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  requester_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  UNIQUE(requester_id, user_id)
+);
+
+CREATE INDEX idx_follow_request_user_id ON follow_request (user_id);
+
+-- user_block
+CREATE TABLE user_block (
+  id SERIAL PRIMARY KEY,
+  user_id INTEGER NOT NULL,
+  blocked_user_id INTEGER NOT NULL,
+  type TEXT NOT NULL CHECK (type IN ('BLOCK', 'MUTE')),
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(user_id, blocked_user_id, type)
+);
+
+CREATE INDEX idx_user_block_blocked_user_id ON user_block (blocked_user_id);
+
+-- user_group
+CREATE TABLE user_group (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  name TEXT NOT NULL
+);
+
+-- user_group_member
+CREATE TABLE user_group_member (
+  group_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(group_id, user_id)
+);
+
+CREATE INDEX idx_user_group_member_user_id ON user_group_member (user_id);
@@ -0,0 +1,44 @@
+-- Rebuild the memo table to allow the TRASHED row status and add trashed_ts.
+DROP TABLE IF EXISTS _memo_old;
+
+ALTER TABLE memo RENAME TO _memo_old;
+
+CREATE TABLE
+  memo (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    uid TEXT NOT NULL UNIQUE,
+    creator_id INTEGER NOT NULL,
+    created_ts BIGINT NOT NULL DEFAULT (strftime ('%s', 'now')),
+    updated_ts BIGINT NOT NULL DEFAULT (strftime ('%s', 'now')),
+    trashed_ts BIGINT NOT NULL DEFAULT 0,
+    row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED', 'TRASHED')) DEFAULT 'NORMAL',
+    content TEXT NOT NULL DEFAULT '',
+    visibility TEXT NOT NULL CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'PRIVATE')) DEFAULT 'PRIVATE',
+    pinned INTEGER NOT NULL CHECK (pinned IN (0, 1)) DEFAULT 0,
+    payload TEXT NOT NULL DEFAULT '{}'
+  );
+
+INSERT INTO
+  memo (id, uid, creator_id, created_ts, updated_ts, row_status, content, visibility, pinned, payload)
+SELECT
+  id, uid, creator_id, created_ts, updated_ts, row_status, content, visibility, pinned, payload
+FROM
+  _memo_old;
+
+-- Dropping the old table also drops its index and full-text triggers, recreate them on the new table.
+DROP TABLE IF EXISTS _memo_old;
+
+CREATE INDEX idx_memo_creator_id ON memo (creator_id);
+
+CREATE TRIGGER memo_fts_insert AFTER INSERT ON memo BEGIN
+  INSERT INTO memo_fts (rowid, content) VALUES (new.id, new.content);
+END;
+
+CREATE TRIGGER memo_fts_delete AFTER DELETE ON memo BEGIN
+  INSERT INTO memo_fts (memo_fts, rowid, content) VALUES ('delete', old.id, old.content);
+END;
+
+CREATE TRIGGER memo_fts_update AFTER UPDATE OF content ON memo BEGIN
+  INSERT INTO memo_fts (memo_fts, rowid, content) VALUES ('delete', old.id, old.content);
+  INSERT INTO memo_fts (rowid, content) VALUES (new.id, new.content);
+END;
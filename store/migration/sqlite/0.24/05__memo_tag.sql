@@ -0,0 +1,13 @@
+-- memo_tag
+CREATE TABLE memo_tag (
+  memo_id INTEGER NOT NULL,
+  tag TEXT NOT NULL,
+  UNIQUE (memo_id, tag)
+);
+
+CREATE INDEX idx_memo_tag_tag ON memo_tag (tag);
+
+INSERT OR IGNORE INTO memo_tag (memo_id, tag)
+SELECT memo.id, json_each.value
+FROM memo, json_each(memo.payload, '$.tags')
+WHERE json_type(memo.payload, '$.tags') = 'array';
@@ -0,0 +1,9 @@
+-- memo_acl
+CREATE TABLE memo_acl (
+  memo_id INTEGER NOT NULL,
+  principal TEXT NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('READER', 'EDITOR')) DEFAULT 'READER',
+  UNIQUE (memo_id, principal)
+);
+
+CREATE INDEX idx_memo_acl_principal ON memo_acl (principal);
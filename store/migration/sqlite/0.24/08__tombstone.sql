@@ -0,0 +1,16 @@
+-- Index the updated time for the delta sync.
+CREATE INDEX idx_memo_creator_id_updated_ts ON memo (creator_id, updated_ts);
+
+CREATE INDEX idx_resource_creator_id_updated_ts ON resource (creator_id, updated_ts);
+
+-- tombstone
+CREATE TABLE tombstone (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  type TEXT NOT NULL CHECK (type IN ('MEMO', 'RESOURCE')),
+  uid TEXT NOT NULL,
+  reason TEXT NOT NULL CHECK (reason IN ('DELETED', 'ARCHIVED'))
+);
+
+CREATE INDEX idx_tombstone_creator_id_created_ts ON tombstone (creator_id, created_ts);
@@ -0,0 +1,12 @@
+-- idempotency_key
+CREATE TABLE idempotency_key (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  key TEXT NOT NULL,
+  method TEXT NOT NULL,
+  name TEXT NOT NULL DEFAULT '',
+  UNIQUE(creator_id, method, key)
+);
+
+CREATE INDEX idx_idempotency_key_created_ts ON idempotency_key (created_ts);
@@ -0,0 +1,9 @@
+-- user_following
+CREATE TABLE IF NOT EXISTS user_following (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL,
+  following_user_id INTEGER NOT NULL,
+  tag TEXT NOT NULL DEFAULT 'default',
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  UNIQUE(user_id, following_user_id)
+);
@@ -0,0 +1,6 @@
+-- The trigram tokenizer matches substrings, so text without word boundaries such as CJK is searchable.
+DROP TABLE memo_fts;
+
+CREATE VIRTUAL TABLE memo_fts USING fts5 (content, content = 'memo', content_rowid = 'id', tokenize = 'trigram');
+
+INSERT INTO memo_fts (memo_fts) VALUES ('rebuild');
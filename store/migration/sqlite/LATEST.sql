@@ -71,15 +71,51 @@ CREATE TABLE
     creator_id INTEGER NOT NULL,
     created_ts BIGINT NOT NULL DEFAULT (strftime ('%s', 'now')),
     updated_ts BIGINT NOT NULL DEFAULT (strftime ('%s', 'now')),
-    row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
+    trashed_ts BIGINT NOT NULL DEFAULT 0,
+    row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED', 'TRASHED')) DEFAULT 'NORMAL',
     content TEXT NOT NULL DEFAULT '',
-    visibility TEXT NOT NULL CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'PRIVATE')) DEFAULT 'PRIVATE',
+    visibility TEXT NOT NULL CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'FOLLOWERS', 'PRIVATE')) DEFAULT 'PRIVATE',
     pinned INTEGER NOT NULL CHECK (pinned IN (0, 1)) DEFAULT 0,
-    payload TEXT NOT NULL DEFAULT '{}'
+    payload TEXT NOT NULL DEFAULT '{}',
+    version INTEGER NOT NULL DEFAULT 0,
+    changed_ts BIGINT NOT NULL DEFAULT 0
   );
 
 CREATE INDEX idx_memo_creator_id ON memo (creator_id);
 
+CREATE INDEX idx_memo_creator_id_updated_ts ON memo (creator_id, updated_ts);
+
+CREATE INDEX idx_memo_creator_id_changed_ts ON memo (creator_id, changed_ts);
+
+-- memo_fts
+CREATE VIRTUAL TABLE memo_fts USING fts5 (content, content = 'memo', content_rowid = 'id', tokenize = 'trigram');
+
+CREATE TRIGGER memo_fts_insert AFTER INSERT ON memo BEGIN
+  INSERT INTO memo_fts (rowid, content) VALUES (new.id, new.content);
+END;
+
+CREATE TRIGGER memo_fts_delete AFTER DELETE ON memo BEGIN
+  INSERT INTO memo_fts (memo_fts, rowid, content) VALUES ('delete', old.id, old.content);
+END;
+
+CREATE TRIGGER memo_fts_update AFTER UPDATE OF content ON memo BEGIN
+  INSERT INTO memo_fts (memo_fts, rowid, content) VALUES ('delete', old.id, old.content);
+  INSERT INTO memo_fts (rowid, content) VALUES (new.id, new.content);
+END;
+
+-- memo_revision
+CREATE TABLE
+  memo_revision (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    memo_id INTEGER NOT NULL,
+    creator_id INTEGER NOT NULL,
+    created_ts BIGINT NOT NULL DEFAULT (strftime ('%s', 'now')),
+    content TEXT NOT NULL DEFAULT '',
+    visibility TEXT NOT NULL CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'FOLLOWERS', 'PRIVATE')) DEFAULT 'PRIVATE'
+  );
+
+CREATE INDEX idx_memo_revision_memo_id ON memo_revision (memo_id);
+
 -- memo_organizer
 CREATE TABLE
   memo_organizer (
@@ -98,6 +134,55 @@ CREATE TABLE
     UNIQUE (memo_id, related_memo_id, type)
   );
 
+-- memo_tag
+CREATE TABLE
+  memo_tag (
+    memo_id INTEGER NOT NULL,
+    tag TEXT NOT NULL,
+    UNIQUE (memo_id, tag)
+  );
+
+CREATE INDEX idx_memo_tag_tag ON memo_tag (tag);
+
+-- memo_share
+CREATE TABLE
+  memo_share (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    memo_id INTEGER NOT NULL,
+    creator_id INTEGER NOT NULL,
+    created_ts BIGINT NOT NULL DEFAULT (strftime ('%s', 'now')),
+    token TEXT NOT NULL UNIQUE,
+    expires_ts BIGINT NOT NULL DEFAULT 0,
+    max_views INTEGER NOT NULL DEFAULT 0,
+    view_count INTEGER NOT NULL DEFAULT 0
+  );
+
+CREATE INDEX idx_memo_share_memo_id ON memo_share (memo_id);
+
+-- memo_acl
+CREATE TABLE
+  memo_acl (
+    memo_id INTEGER NOT NULL,
+    principal TEXT NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('READER', 'EDITOR')) DEFAULT 'READER',
+    UNIQUE (memo_id, principal)
+  );
+
+CREATE INDEX idx_memo_acl_principal ON memo_acl (principal);
+
+-- tombstone
+CREATE TABLE
+  tombstone (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    creator_id INTEGER NOT NULL,
+    created_ts BIGINT NOT NULL DEFAULT (strftime ('%s', 'now')),
+    type TEXT NOT NULL CHECK (type IN ('MEMO', 'RESOURCE')),
+    uid TEXT NOT NULL,
+    reason TEXT NOT NULL CHECK (reason IN ('DELETED', 'ARCHIVED'))
+  );
+
+CREATE INDEX idx_tombstone_creator_id_created_ts ON tombstone (creator_id, created_ts);
+
 -- resource
 CREATE TABLE
   resource (
@@ -113,13 +198,16 @@ CREATE TABLE
     memo_id INTEGER,
     storage_type TEXT NOT NULL DEFAULT '',
     reference TEXT NOT NULL DEFAULT '',
-    payload TEXT NOT NULL DEFAULT '{}'
+    payload TEXT NOT NULL DEFAULT '{}',
+    version INTEGER NOT NULL DEFAULT 0
   );
 
 CREATE INDEX idx_resource_creator_id ON resource (creator_id);
 
 CREATE INDEX idx_resource_memo_id ON resource (memo_id);
 
+CREATE INDEX idx_resource_creator_id_updated_ts ON resource (creator_id, updated_ts);
+
 -- activity
 CREATE TABLE
   activity (
@@ -175,4 +263,90 @@ CREATE TABLE
     content_id TEXT NOT NULL,
     reaction_type TEXT NOT NULL,
     UNIQUE (creator_id, content_id, reaction_type)
-  );
\ No newline at end of file
+  );
+-- idempotency_key
+CREATE TABLE idempotency_key (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  key TEXT NOT NULL,
+  method TEXT NOT NULL,
+  name TEXT NOT NULL DEFAULT '',
+  UNIQUE(creator_id, method, key)
+);
+
+CREATE INDEX idx_idempotency_key_created_ts ON idempotency_key (created_ts);
+
+-- channel
+CREATE TABLE channel (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  type TEXT NOT NULL CHECK (type IN ('DIRECT', 'GROUP')),
+  name TEXT NOT NULL DEFAULT ''
+);
+
+-- channel_member
+CREATE TABLE channel_member (
+  channel_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  last_read_message_id INTEGER NOT NULL DEFAULT 0,
+  UNIQUE(channel_id, user_id)
+);
+
+CREATE INDEX idx_channel_member_user_id ON channel_member (user_id);
+
+-- message
+CREATE TABLE message (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  channel_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  content TEXT NOT NULL DEFAULT '',
+  memo_id INTEGER
+);
+
+CREATE INDEX idx_message_channel_id ON message (channel_id, id);
+
+-- follow_request
+CREATE TABLE follow_request (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  requester_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  UNIQUE(requester_id, user_id)
+);
+
+CREATE INDEX idx_follow_request_user_id ON follow_request (user_id);
+
+-- user_block
+CREATE TABLE user_block (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  user_id INTEGER NOT NULL,
+  blocked_user_id INTEGER NOT NULL,
+  type TEXT NOT NULL CHECK (type IN ('BLOCK', 'MUTE')),
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  UNIQUE(user_id, blocked_user_id, type)
+);
+
+CREATE INDEX idx_user_block_blocked_user_id ON user_block (blocked_user_id);
+
+-- user_group
+CREATE TABLE user_group (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  name TEXT NOT NULL
+);
+
+-- user_group_member
+CREATE TABLE user_group_member (
+  group_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  UNIQUE(group_id, user_id)
+);
+
+CREATE INDEX idx_user_group_member_user_id ON user_group_member (user_id);
@@ -15,9 +15,10 @@ type Reaction struct {
 }
 
 type FindReaction struct {
-	ID        *int32
-	CreatorID *int32
-	ContentID *string
+	ID            *int32
+	CreatorID     *int32
+	ContentID     *string
+	ContentIDList []string
 }
 
 type DeleteReaction struct {
@@ -32,6 +32,8 @@ type Resource struct {
 	StorageType storepb.ResourceStorageType
 	Reference   string
 	Payload     *storepb.ResourcePayload
+	// Version is bumped on every update of the resource.
+	Version int32
 
 	// The related memo ID.
 	MemoID *int32
@@ -45,8 +47,10 @@ type FindResource struct {
 	Filename       *string
 	FilenameSearch *string
 	MemoID         *int32
+	MemoIDList     []int32
 	HasRelatedMemo bool
 	StorageType    *storepb.ResourceStorageType
+	UpdatedTsAfter *int64
 	Limit          *int
 	Offset         *int
 }
@@ -59,8 +63,14 @@ type UpdateResource struct {
 	MemoID    *int32
 	Reference *string
 	Payload   *storepb.ResourcePayload
+	// Version is the version of the resource the update is based on, if set the update fails with
+	// ErrResourceVersionMismatch when the resource has been updated since.
+	Version *int32
 }
 
+// ErrResourceVersionMismatch is returned by UpdateResource when the resource has been updated since the version of the update.
+var ErrResourceVersionMismatch = errors.New("resource version mismatch")
+
 type DeleteResource struct {
 	ID     int32
 	MemoID *int32
@@ -151,5 +161,16 @@ func (s *Store) DeleteResource(ctx context.Context, delete *DeleteResource) erro
 		}
 	}
 
-	return s.driver.DeleteResource(ctx, delete)
+	if err := s.driver.DeleteResource(ctx, delete); err != nil {
+		return err
+	}
+	if _, err := s.CreateTombstone(ctx, &Tombstone{
+		CreatorID: resource.CreatorID,
+		Type:      TombstoneTypeResource,
+		UID:       resource.UID,
+		Reason:    TombstoneReasonDeleted,
+	}); err != nil {
+		return errors.Wrap(err, "failed to create resource tombstone")
+	}
+	return nil
 }
@@ -1,8 +1,11 @@
 package store
 
 import (
+	"context"
 	"sync"
 
+	"github.com/pkg/errors"
+
 	"github.com/usememos/memos/server/profile"
 )
 
@@ -15,6 +18,8 @@ type Store struct {
 	userFollowCache       sync.Map // map[int]*storepb.UserFollow.UserID:[int]*storepb.UserFollow.FollowingUserID
 	userSettingCache      sync.Map // map[string]*storepb.UserSetting
 	idpCache              sync.Map // map[int]*storepb.IdentityProvider
+	// inTx reports whether the driver is bound to a transaction.
+	inTx bool
 }
 
 // New creates a new instance of Store.
@@ -32,3 +37,49 @@ func (s *Store) GetDriver() Driver {
 func (s *Store) Close() error {
 	return s.driver.Close()
 }
+
+// WithTx runs fn with a store bound to a new transaction, which is committed if fn returns nil
+// and rolled back otherwise. Nested calls run in the outer transaction.
+func (s *Store) WithTx(ctx context.Context, fn func(tx *Store) error) error {
+	if s.inTx {
+		return fn(s)
+	}
+
+	txDriver, err := s.driver.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = txDriver.Rollback()
+			panic(p)
+		}
+	}()
+
+	// The transaction store has its own caches so uncommitted rows never leak into the shared ones.
+	txStore := &Store{
+		Profile: s.Profile,
+		driver:  txDriver,
+		inTx:    true,
+	}
+	if err := fn(txStore); err != nil {
+		if rollbackErr := txDriver.Rollback(); rollbackErr != nil {
+			return errors.Wrapf(err, "failed to rollback transaction: %v", rollbackErr)
+		}
+		return err
+	}
+	if err := txDriver.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+	s.clearCaches()
+	return nil
+}
+
+// clearCaches drops all cached rows so they are reloaded after a transaction is committed.
+func (s *Store) clearCaches() {
+	s.workspaceSettingCache.Clear()
+	s.userCache.Clear()
+	s.userFollowCache.Clear()
+	s.userSettingCache.Clear()
+	s.idpCache.Clear()
+}
@@ -0,0 +1,48 @@
+package store
+
+import (
+	"context"
+)
+
+// TombstoneType is the type of the item removed.
+type TombstoneType string
+
+const (
+	TombstoneTypeMemo     TombstoneType = "MEMO"
+	TombstoneTypeResource TombstoneType = "RESOURCE"
+)
+
+// TombstoneReason is the reason the item is removed.
+type TombstoneReason string
+
+const (
+	TombstoneReasonDeleted  TombstoneReason = "DELETED"
+	TombstoneReasonArchived TombstoneReason = "ARCHIVED"
+)
+
+// Tombstone records a memo or resource removed by its creator, so the sync clients can remove their copies.
+type Tombstone struct {
+	ID        int32
+	CreatorID int32
+	CreatedTs int64
+
+	Type TombstoneType
+	// UID is the uid of the removed memo or resource.
+	UID    string
+	Reason TombstoneReason
+}
+
+type FindTombstone struct {
+	ID             *int32
+	CreatorID      *int32
+	Type           *TombstoneType
+	CreatedTsAfter *int64
+}
+
+func (s *Store) CreateTombstone(ctx context.Context, create *Tombstone) (*Tombstone, error) {
+	return s.driver.CreateTombstone(ctx, create)
+}
+
+func (s *Store) ListTombstones(ctx context.Context, find *FindTombstone) ([]*Tombstone, error) {
+	return s.driver.ListTombstones(ctx, find)
+}
@@ -88,6 +88,9 @@ type UpdateUser struct {
 	University   *string
 }
 
+// PrivateProfileFields are the profile fields the users can hide from the others.
+var PrivateProfileFields = []string{"description", "gender", "birth_date", "location", "industry", "occupation", "university"}
+
 type FindUser struct {
 	ID        *int32
 	RowStatus *RowStatus
@@ -95,9 +98,12 @@ type FindUser struct {
 	Role      *Role
 	Email     *string
 	Nickname  *string
+	// Filter is a CEL expression on the profile fields, the private fields of the users never match.
+	Filter *string
 
 	// The maximum number of users to return.
-	Limit *int
+	Limit  *int
+	Offset *int
 }
 
 type DeleteUser struct {
@@ -0,0 +1,60 @@
+package store
+
+import (
+	"context"
+)
+
+type UserBlockType string
+
+const (
+	// UserBlockTypeBlock stops the blocked user from commenting, reacting, following and sending messages.
+	UserBlockTypeBlock UserBlockType = "BLOCK"
+	// UserBlockTypeMute leaves the memos of the muted user out of the listed memos.
+	UserBlockTypeMute UserBlockType = "MUTE"
+)
+
+func (t UserBlockType) String() string {
+	return string(t)
+}
+
+type UserBlock struct {
+	UserID        int32
+	BlockedUserID int32
+	Type          UserBlockType
+	CreatedTs     int64
+}
+
+type FindUserBlock struct {
+	UserID        *int32
+	BlockedUserID *int32
+	Type          *UserBlockType
+}
+
+type DeleteUserBlock struct {
+	UserID        int32
+	BlockedUserID int32
+	Type          UserBlockType
+}
+
+func (s *Store) UpsertUserBlock(ctx context.Context, upsert *UserBlock) (*UserBlock, error) {
+	return s.driver.UpsertUserBlock(ctx, upsert)
+}
+
+func (s *Store) ListUserBlocks(ctx context.Context, find *FindUserBlock) ([]*UserBlock, error) {
+	return s.driver.ListUserBlocks(ctx, find)
+}
+
+func (s *Store) GetUserBlock(ctx context.Context, find *FindUserBlock) (*UserBlock, error) {
+	list, err := s.ListUserBlocks(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteUserBlock(ctx context.Context, delete *DeleteUserBlock) error {
+	return s.driver.DeleteUserBlock(ctx, delete)
+}
@@ -12,6 +12,21 @@ type UserFollowing struct {
 	CreatedTs       int64
 }
 
+// DefaultFollowTag is the tag of the followed users not grouped into any circle.
+const DefaultFollowTag = "default"
+
+type FindUserFollowing struct {
+	UserID          *int32
+	FollowingUserID *int32
+	Tag             *string
+}
+
+type UpdateUserFollowing struct {
+	UserID          int32
+	FollowingUserID int32
+	Tag             *string
+}
+
 // 查询当前用户是否已关注某个指定用户
 func (s *Store) IsFollowingUser(ctx context.Context, follow *UserFollowing) (bool, error) {
 	// 调用底层数据驱动的方法来检查是否存在对应的关注记录
@@ -60,3 +75,22 @@ func (s *Store) GetFollowerList(ctx context.Context, userID int32) ([]*User, err
 	}
 	return followerList, nil
 }
+
+func (s *Store) ListUserFollowings(ctx context.Context, find *FindUserFollowing) ([]*UserFollowing, error) {
+	return s.driver.ListUserFollowings(ctx, find)
+}
+
+func (s *Store) GetUserFollowing(ctx context.Context, find *FindUserFollowing) (*UserFollowing, error) {
+	list, err := s.ListUserFollowings(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateUserFollowing(ctx context.Context, update *UpdateUserFollowing) error {
+	return s.driver.UpdateUserFollowing(ctx, update)
+}
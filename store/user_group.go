@@ -0,0 +1,99 @@
+package store
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// UserGroup is a group of users, a memo shared with the group by its ACL is shared with all the members.
+type UserGroup struct {
+	ID        int32
+	CreatorID int32
+	CreatedTs int64
+	Name      string
+}
+
+type FindUserGroup struct {
+	ID *int32
+	// MemberID finds the groups the user is a member of.
+	MemberID *int32
+}
+
+type UpdateUserGroup struct {
+	ID   int32
+	Name *string
+}
+
+type DeleteUserGroup struct {
+	ID int32
+}
+
+type UserGroupMember struct {
+	GroupID   int32
+	UserID    int32
+	CreatedTs int64
+}
+
+type FindUserGroupMember struct {
+	GroupID     *int32
+	GroupIDList []int32
+	UserID      *int32
+}
+
+type DeleteUserGroupMember struct {
+	GroupID *int32
+	UserID  *int32
+}
+
+func (s *Store) CreateUserGroup(ctx context.Context, create *UserGroup) (*UserGroup, error) {
+	return s.driver.CreateUserGroup(ctx, create)
+}
+
+func (s *Store) ListUserGroups(ctx context.Context, find *FindUserGroup) ([]*UserGroup, error) {
+	return s.driver.ListUserGroups(ctx, find)
+}
+
+func (s *Store) GetUserGroup(ctx context.Context, find *FindUserGroup) (*UserGroup, error) {
+	list, err := s.ListUserGroups(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateUserGroup(ctx context.Context, update *UpdateUserGroup) (*UserGroup, error) {
+	return s.driver.UpdateUserGroup(ctx, update)
+}
+
+// DeleteUserGroup deletes the group along with its members.
+func (s *Store) DeleteUserGroup(ctx context.Context, delete *DeleteUserGroup) error {
+	return s.WithTx(ctx, func(tx *Store) error {
+		if err := tx.driver.DeleteUserGroupMember(ctx, &DeleteUserGroupMember{GroupID: &delete.ID}); err != nil {
+			return errors.Wrap(err, "failed to delete user group members")
+		}
+		return tx.driver.DeleteUserGroup(ctx, delete)
+	})
+}
+
+func (s *Store) ListUserGroupMembers(ctx context.Context, find *FindUserGroupMember) ([]*UserGroupMember, error) {
+	return s.driver.ListUserGroupMembers(ctx, find)
+}
+
+// SetUserGroupMembers replaces the members of the group.
+func (s *Store) SetUserGroupMembers(ctx context.Context, groupID int32, userIDList []int32) error {
+	return s.WithTx(ctx, func(tx *Store) error {
+		if err := tx.driver.DeleteUserGroupMember(ctx, &DeleteUserGroupMember{GroupID: &groupID}); err != nil {
+			return errors.Wrap(err, "failed to delete user group members")
+		}
+		for _, userID := range userIDList {
+			if err := tx.driver.CreateUserGroupMember(ctx, &UserGroupMember{GroupID: groupID, UserID: userID}); err != nil {
+				return errors.Wrap(err, "failed to create user group member")
+			}
+		}
+		return nil
+	})
+}
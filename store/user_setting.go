@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -151,12 +152,30 @@ func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 			return nil, err
 		}
 		userSetting.Value = &storepb.UserSetting_Shortcuts{Shortcuts: shortcutsUserSetting}
+	case storepb.UserSettingKey_TAGS:
+		tagsUserSetting := &storepb.TagsUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), tagsUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Tags{Tags: tagsUserSetting}
 	case storepb.UserSettingKey_LOCALE:
 		userSetting.Value = &storepb.UserSetting_Locale{Locale: raw.Value}
 	case storepb.UserSettingKey_APPEARANCE:
 		userSetting.Value = &storepb.UserSetting_Appearance{Appearance: raw.Value}
 	case storepb.UserSettingKey_MEMO_VISIBILITY:
 		userSetting.Value = &storepb.UserSetting_MemoVisibility{MemoVisibility: raw.Value}
+	case storepb.UserSettingKey_APPROVE_FOLLOWERS:
+		approveFollowers, err := strconv.ParseBool(raw.Value)
+		if err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_ApproveFollowers{ApproveFollowers: approveFollowers}
+	case storepb.UserSettingKey_PROFILE_PRIVACY:
+		profilePrivacyUserSetting := &storepb.ProfilePrivacyUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), profilePrivacyUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_ProfilePrivacy{ProfilePrivacy: profilePrivacyUserSetting}
 	default:
 		return nil, nil
 	}
@@ -184,12 +203,28 @@ func convertUserSettingToRaw(userSetting *storepb.UserSetting) (*UserSetting, er
 			return nil, err
 		}
 		raw.Value = string(value)
+	case storepb.UserSettingKey_TAGS:
+		tagsUserSetting := userSetting.GetTags()
+		value, err := protojson.Marshal(tagsUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
 	case storepb.UserSettingKey_LOCALE:
 		raw.Value = userSetting.GetLocale()
 	case storepb.UserSettingKey_APPEARANCE:
 		raw.Value = userSetting.GetAppearance()
 	case storepb.UserSettingKey_MEMO_VISIBILITY:
 		raw.Value = userSetting.GetMemoVisibility()
+	case storepb.UserSettingKey_APPROVE_FOLLOWERS:
+		raw.Value = strconv.FormatBool(userSetting.GetApproveFollowers())
+	case storepb.UserSettingKey_PROFILE_PRIVACY:
+		profilePrivacyUserSetting := userSetting.GetProfilePrivacy()
+		value, err := protojson.Marshal(profilePrivacyUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
 	default:
 		return nil, errors.Errorf("unsupported user setting key: %v", userSetting.Key)
 	}
@@ -140,6 +140,12 @@ func (s *Store) GetWorkspaceGeneralSetting(ctx context.Context) (*storepb.Worksp
 // DefaultContentLengthLimit is the default limit of content length in bytes. 8KB.
 const DefaultContentLengthLimit = 8 * 1024
 
+// DefaultMemoRevisionLimit is the default max number of revisions kept per memo.
+const DefaultMemoRevisionLimit = 20
+
+// DefaultTrashRetentionDays is the default number of days memos are kept in the trash.
+const DefaultTrashRetentionDays = 30
+
 // DefaultReactions is the default reactions for memo related setting.
 var DefaultReactions = []string{"👍", "👎", "❤️", "🎉", "😄", "😕", "😢", "😡"}
 
@@ -158,6 +164,12 @@ func (s *Store) GetWorkspaceMemoRelatedSetting(ctx context.Context) (*storepb.Wo
 	if workspaceMemoRelatedSetting.ContentLengthLimit < DefaultContentLengthLimit {
 		workspaceMemoRelatedSetting.ContentLengthLimit = DefaultContentLengthLimit
 	}
+	if workspaceMemoRelatedSetting.MemoRevisionLimit <= 0 {
+		workspaceMemoRelatedSetting.MemoRevisionLimit = DefaultMemoRevisionLimit
+	}
+	if workspaceMemoRelatedSetting.TrashRetentionDays <= 0 {
+		workspaceMemoRelatedSetting.TrashRetentionDays = DefaultTrashRetentionDays
+	}
 	if len(workspaceMemoRelatedSetting.Reactions) == 0 {
 		workspaceMemoRelatedSetting.Reactions = append(workspaceMemoRelatedSetting.Reactions, DefaultReactions...)
 	}
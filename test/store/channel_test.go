@@ -0,0 +1,88 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestChannelStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	otherUser, err := ts.CreateUser(ctx, &store.User{
+		Username: "other",
+		Role:     store.RoleUser,
+		Email:    "other@test.com",
+		Nickname: "other_nickname",
+	})
+	require.NoError(t, err)
+	channel, err := ts.CreateChannel(ctx, &store.Channel{
+		CreatorID: user.ID,
+		Type:      store.ChannelTypeDirect,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, channel.UpdatedTs)
+	for _, userID := range []int32{user.ID, otherUser.ID} {
+		_, err = ts.CreateChannelMember(ctx, &store.ChannelMember{
+			ChannelID: channel.ID,
+			UserID:    userID,
+		})
+		require.NoError(t, err)
+	}
+	channels, err := ts.ListChannels(ctx, &store.FindChannel{MemberID: &otherUser.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(channels))
+	members, err := ts.ListChannelMembers(ctx, &store.FindChannelMember{ChannelIDList: []int32{channel.ID}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(members))
+
+	// The messages of others are unread until the member reads them.
+	messages := []*store.Message{}
+	for _, creatorID := range []int32{user.ID, otherUser.ID, otherUser.ID} {
+		message, err := ts.CreateMessage(ctx, &store.Message{
+			ChannelID: channel.ID,
+			CreatorID: creatorID,
+			Content:   "test message",
+		})
+		require.NoError(t, err)
+		messages = append(messages, message)
+	}
+	unreadCounts, err := ts.CountUnreadMessages(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), unreadCounts[channel.ID])
+	unreadCounts, err = ts.CountUnreadMessages(ctx, otherUser.ID)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), unreadCounts[channel.ID])
+	err = ts.UpdateChannelMember(ctx, &store.UpdateChannelMember{
+		ChannelID:         channel.ID,
+		UserID:            user.ID,
+		LastReadMessageID: &messages[2].ID,
+	})
+	require.NoError(t, err)
+	unreadCounts, err = ts.CountUnreadMessages(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(unreadCounts))
+
+	// The messages are listed from the newest, after the cursor if any.
+	limit := 2
+	list, err := ts.ListMessages(ctx, &store.FindMessage{
+		ChannelID: &channel.ID,
+		Limit:     &limit,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(list))
+	require.Equal(t, messages[2].ID, list[0].ID)
+	list, err = ts.ListMessages(ctx, &store.FindMessage{
+		ChannelID: &channel.ID,
+		Cursor:    &store.Cursor{ID: list[1].ID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list))
+	require.Equal(t, messages[0].ID, list[0].ID)
+	ts.Close()
+}
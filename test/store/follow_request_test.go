@@ -0,0 +1,57 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestFollowRequestStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	requester, err := ts.CreateUser(ctx, &store.User{
+		Username: "requester",
+		Role:     store.RoleUser,
+		Email:    "requester@test.com",
+		Nickname: "requester_nickname",
+	})
+	require.NoError(t, err)
+	followRequest, err := ts.CreateFollowRequest(ctx, &store.FollowRequest{
+		RequesterID: requester.ID,
+		UserID:      user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, requester.ID, followRequest.RequesterID)
+	require.Equal(t, user.ID, followRequest.UserID)
+	followRequests, err := ts.ListFollowRequests(ctx, &store.FindFollowRequest{
+		UserID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(followRequests))
+	require.Equal(t, followRequest, followRequests[0])
+
+	// The requester is not a follower until the request is accepted.
+	userFollowing, err := ts.GetUserFollowing(ctx, &store.FindUserFollowing{
+		UserID:          &requester.ID,
+		FollowingUserID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Nil(t, userFollowing)
+
+	err = ts.DeleteFollowRequest(ctx, &store.DeleteFollowRequest{
+		ID: followRequest.ID,
+	})
+	require.NoError(t, err)
+	followRequest, err = ts.GetFollowRequest(ctx, &store.FindFollowRequest{
+		RequesterID: &requester.ID,
+		UserID:      &user.ID,
+	})
+	require.NoError(t, err)
+	require.Nil(t, followRequest)
+	ts.Close()
+}
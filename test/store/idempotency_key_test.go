@@ -0,0 +1,70 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestIdempotencyKeyStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	method := "/memos.api.v1.MemoService/CreateMemo"
+	idempotencyKey, err := ts.CreateIdempotencyKey(ctx, &store.IdempotencyKey{
+		CreatorID: user.ID,
+		Key:       "test-key",
+		Method:    method,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, idempotencyKey.CreatedTs)
+	require.Equal(t, "", idempotencyKey.Name)
+
+	// The key is unique per user and method.
+	_, err = ts.CreateIdempotencyKey(ctx, &store.IdempotencyKey{
+		CreatorID: user.ID,
+		Key:       "test-key",
+		Method:    method,
+	})
+	require.Error(t, err)
+	_, err = ts.CreateIdempotencyKey(ctx, &store.IdempotencyKey{
+		CreatorID: user.ID,
+		Key:       "test-key",
+		Method:    "/memos.api.v1.ResourceService/CreateResource",
+	})
+	require.NoError(t, err)
+
+	name := "memos/test-memo"
+	err = ts.UpdateIdempotencyKey(ctx, &store.UpdateIdempotencyKey{
+		ID:   idempotencyKey.ID,
+		Name: &name,
+	})
+	require.NoError(t, err)
+	key := "test-key"
+	idempotencyKey, err = ts.GetIdempotencyKey(ctx, &store.FindIdempotencyKey{
+		CreatorID: &user.ID,
+		Key:       &key,
+		Method:    &method,
+	})
+	require.NoError(t, err)
+	require.Equal(t, name, idempotencyKey.Name)
+
+	// The expired keys are deleted.
+	createdTsBefore := idempotencyKey.CreatedTs
+	err = ts.DeleteIdempotencyKey(ctx, &store.DeleteIdempotencyKey{CreatedTsBefore: &createdTsBefore})
+	require.NoError(t, err)
+	idempotencyKeys, err := ts.ListIdempotencyKeys(ctx, &store.FindIdempotencyKey{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(idempotencyKeys))
+	createdTsBefore = idempotencyKey.CreatedTs + 1
+	err = ts.DeleteIdempotencyKey(ctx, &store.DeleteIdempotencyKey{CreatedTsBefore: &createdTsBefore})
+	require.NoError(t, err)
+	idempotencyKeys, err = ts.ListIdempotencyKeys(ctx, &store.FindIdempotencyKey{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(idempotencyKeys))
+	ts.Close()
+}
@@ -0,0 +1,77 @@
+package teststore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoACLStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	privateMemo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "private-memo",
+		CreatorID:  user.ID,
+		Content:    "private content",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "other-private-memo",
+		CreatorID:  user.ID,
+		Content:    "other private content",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "public-memo",
+		CreatorID:  user.ID,
+		Content:    "public content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	err = ts.SetMemoACL(ctx, privateMemo.ID, []*store.MemoACL{
+		{Principal: "users/2", Role: store.MemoACLRoleEditor},
+		{Principal: "users/3", Role: store.MemoACLRoleReader},
+	})
+	require.NoError(t, err)
+	memoACLs, err := ts.ListMemoACLs(ctx, &store.FindMemoACL{MemoID: &privateMemo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memoACLs))
+	require.Equal(t, "users/2", memoACLs[0].Principal)
+	require.Equal(t, store.MemoACLRoleEditor, memoACLs[0].Role)
+
+	// Setting the ACL replaces the previous entries.
+	err = ts.SetMemoACL(ctx, privateMemo.ID, []*store.MemoACL{
+		{Principal: "users/3", Role: store.MemoACLRoleEditor},
+	})
+	require.NoError(t, err)
+	memoACLs, err = ts.ListMemoACLs(ctx, &store.FindMemoACL{MemoIDList: []int32{privateMemo.ID}, PrincipalList: []string{"users/2", "users/3"}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memoACLs))
+	require.Equal(t, store.MemoACLRoleEditor, memoACLs[0].Role)
+
+	// The memos shared by the ACL are listed along with the visible ones.
+	for principal, expected := range map[string]int{"users/3": 2, "users/2": 1} {
+		memos, err := ts.ListMemos(ctx, &store.FindMemo{
+			VisibilityList: []store.Visibility{store.Public, store.Protected},
+			ACLPrincipals:  []string{principal},
+		})
+		require.NoError(t, err)
+		require.Equal(t, expected, len(memos), fmt.Sprintf("memos of %s", principal))
+	}
+
+	err = ts.PurgeMemo(ctx, privateMemo)
+	require.NoError(t, err)
+	memoACLs, err = ts.ListMemoACLs(ctx, &store.FindMemoACL{MemoID: &privateMemo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memoACLs))
+	ts.Close()
+}
@@ -58,5 +58,23 @@ func TestMemoRelationStore(t *testing.T) {
 	}
 	_, err = ts.UpsertMemoRelation(ctx, commentRelation)
 	require.NoError(t, err)
+
+	// Batch loading by memo ID lists.
+	memoRelations, err := ts.ListMemoRelations(ctx, &store.FindMemoRelation{
+		MemoIDList: []int32{memo.ID, relatedMemo.ID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memoRelations))
+	memoRelations, err = ts.ListMemoRelations(ctx, &store.FindMemoRelation{
+		RelatedMemoIDList: []int32{commentMemo.ID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memoRelations))
+	require.Equal(t, store.MemoRelationComment, memoRelations[0].Type)
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{
+		IDList: []int32{relatedMemo.ID, commentMemo.ID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memos))
 	ts.Close()
 }
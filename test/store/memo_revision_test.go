@@ -0,0 +1,65 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoRevisionStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-memo",
+		CreatorID:  user.ID,
+		Content:    "test content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	firstRevision, err := ts.CreateMemoRevision(ctx, &store.MemoRevision{
+		MemoID:     memo.ID,
+		CreatorID:  user.ID,
+		Content:    "test content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "test content", firstRevision.Content)
+	secondRevision, err := ts.CreateMemoRevision(ctx, &store.MemoRevision{
+		MemoID:     memo.ID,
+		CreatorID:  user.ID,
+		Content:    "updated content",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	memoRevisions, err := ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memoRevisions))
+	// Revisions are listed from newest to oldest.
+	require.Equal(t, secondRevision.ID, memoRevisions[0].ID)
+	require.Equal(t, store.Private, memoRevisions[0].Visibility)
+	require.Equal(t, firstRevision.ID, memoRevisions[1].ID)
+
+	memoRevision, err := ts.GetMemoRevision(ctx, &store.FindMemoRevision{ID: &firstRevision.ID})
+	require.NoError(t, err)
+	require.Equal(t, "test content", memoRevision.Content)
+
+	err = ts.DeleteMemoRevision(ctx, &store.DeleteMemoRevision{ID: &firstRevision.ID})
+	require.NoError(t, err)
+	memoRevisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memoRevisions))
+
+	err = ts.DeleteMemoRevision(ctx, &store.DeleteMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	memoRevisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memoRevisions))
+	ts.Close()
+}
@@ -0,0 +1,75 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoShareStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-memo",
+		CreatorID:  user.ID,
+		Content:    "test content",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	memoShare, err := ts.CreateMemoShare(ctx, &store.MemoShare{
+		MemoID:    memo.ID,
+		CreatorID: user.ID,
+		Token:     "test-token",
+		MaxViews:  2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), memoShare.ViewCount)
+	_, err = ts.CreateMemoShare(ctx, &store.MemoShare{
+		MemoID:    memo.ID,
+		CreatorID: user.ID,
+		Token:     "unlimited-token",
+	})
+	require.NoError(t, err)
+
+	memoShares, err := ts.ListMemoShares(ctx, &store.FindMemoShare{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memoShares))
+	token := "test-token"
+	found, err := ts.GetMemoShare(ctx, &store.FindMemoShare{Token: &token})
+	require.NoError(t, err)
+	require.Equal(t, memoShare.ID, found.ID)
+	require.Equal(t, int32(2), found.MaxViews)
+
+	// The views are counted until the maximum is reached.
+	for i := 0; i < 2; i++ {
+		ok, err := ts.IncreaseMemoShareViewCount(ctx, memoShare.ID)
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+	ok, err := ts.IncreaseMemoShareViewCount(ctx, memoShare.ID)
+	require.NoError(t, err)
+	require.False(t, ok)
+	found, err = ts.GetMemoShare(ctx, &store.FindMemoShare{ID: &memoShare.ID})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), found.ViewCount)
+
+	err = ts.DeleteMemoShare(ctx, &store.DeleteMemoShare{ID: &memoShare.ID})
+	require.NoError(t, err)
+	memoShares, err = ts.ListMemoShares(ctx, &store.FindMemoShare{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memoShares))
+
+	// Purging the memo deletes its shares.
+	err = ts.PurgeMemo(ctx, memo)
+	require.NoError(t, err)
+	memoShares, err = ts.ListMemoShares(ctx, &store.FindMemoShare{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memoShares))
+	ts.Close()
+}
@@ -0,0 +1,71 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoTagStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "go-memo",
+		CreatorID:  user.ID,
+		Content:    "#go #go/generics",
+		Visibility: store.Public,
+		Payload:    &storepb.MemoPayload{Tags: []string{"go", "go/generics"}},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "golang-memo",
+		CreatorID:  user.ID,
+		Content:    "#golang",
+		Visibility: store.Public,
+		Payload:    &storepb.MemoPayload{Tags: []string{"golang"}},
+	})
+	require.NoError(t, err)
+
+	memoTags, err := ts.ListMemoTags(ctx, &store.FindMemoTag{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memoTags))
+
+	// Tags sharing a prefix do not match each other, sub tags do.
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{
+		PayloadFind: &store.FindMemoPayload{TagSearch: []string{"go"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memos))
+	require.Equal(t, memo.ID, memos[0].ID)
+	filter := `tag in ["golang"]`
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{Filter: &filter})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memos))
+	require.Equal(t, "golang-memo", memos[0].UID)
+
+	// Updating the payload replaces the tags.
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:      memo.ID,
+		Payload: &storepb.MemoPayload{Tags: []string{"golang"}},
+	})
+	require.NoError(t, err)
+	memoTagCounts, err := ts.CountMemoTags(ctx, &store.FindMemoTagCount{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memoTagCounts))
+	require.Equal(t, "golang", memoTagCounts[0].Tag)
+	require.Equal(t, int32(2), memoTagCounts[0].Count)
+	require.NotZero(t, memoTagCounts[0].LastUsedTs)
+
+	err = ts.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID})
+	require.NoError(t, err)
+	memoTags, err = ts.ListMemoTags(ctx, &store.FindMemoTag{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memoTags))
+	ts.Close()
+}
@@ -2,6 +2,7 @@ package teststore
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -116,3 +117,210 @@ func TestDeleteMemoStore(t *testing.T) {
 	require.NoError(t, err)
 	ts.Close()
 }
+
+func TestSearchMemoStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "search-memo",
+		CreatorID:  user.ID,
+		Content:    "the quick brown fox jumps over the lazy dog",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "private-memo",
+		CreatorID:  user.ID,
+		Content:    "a private fox",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	results, err := ts.SearchMemos(ctx, &store.SearchMemo{
+		Query:          "fox",
+		VisibilityList: []store.Visibility{store.Public},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(results))
+	require.Equal(t, memo.ID, results[0].Memo.ID)
+	require.Contains(t, results[0].Snippet, store.SearchHighlightStart+"fox"+store.SearchHighlightEnd)
+
+	// The index follows content updates.
+	content := "a slow turtle"
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Content: &content})
+	require.NoError(t, err)
+	results, err = ts.SearchMemos(ctx, &store.SearchMemo{Query: "turtle"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(results))
+	results, err = ts.SearchMemos(ctx, &store.SearchMemo{Query: "quick"})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(results))
+
+	// The index follows deletions.
+	err = ts.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID})
+	require.NoError(t, err)
+	results, err = ts.SearchMemos(ctx, &store.SearchMemo{Query: "turtle"})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(results))
+
+	// The memo content is escaped in snippets, only the highlight tags are markup.
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "html-memo",
+		CreatorID:  user.ID,
+		Content:    "<img src=x onerror=alert(1)> <mark>hello</mark>",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	results, err = ts.SearchMemos(ctx, &store.SearchMemo{Query: "hello"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(results))
+	require.NotContains(t, results[0].Snippet, "<img")
+	require.Contains(t, results[0].Snippet, "&lt;img src=x onerror=alert(1)&gt;")
+	require.Contains(t, results[0].Snippet, "&lt;mark&gt;"+store.SearchHighlightStart+"hello"+store.SearchHighlightEnd+"&lt;/mark&gt;")
+	ts.Close()
+}
+
+func TestSearchMemoWildcards(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	if ts.Profile.Driver == "mysql" {
+		t.Skip("the full-text search of mysql has no wildcards")
+	}
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	for i, content := range []string{"100% done", "1000 done", "a_b", "axb", `c\d`, "cd"} {
+		_, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("wildcard-memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+	}
+
+	// The wildcards of LIKE in the query match themselves only.
+	for query, content := range map[string]string{"100%": "100% done", "a_b": "a_b", `c\d`: `c\d`} {
+		results, err := ts.SearchMemos(ctx, &store.SearchMemo{Query: query})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(results), query)
+		require.Equal(t, content, results[0].Memo.Content)
+	}
+	ts.Close()
+}
+
+func TestMemoContentSearchCJK(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "cjk-memo",
+		CreatorID:  user.ID,
+		Content:    "今天天气很好，适合写笔记",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "other-memo",
+		CreatorID:  user.ID,
+		Content:    "明天下雨",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{ContentSearch: []string{"天气"}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memoList))
+	require.Equal(t, memo.ID, memoList[0].ID)
+	filter := `content.contains("写笔记")`
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{Filter: &filter})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memoList))
+	require.Equal(t, memo.ID, memoList[0].ID)
+
+	// The full-text search matches CJK terms of any length.
+	for _, query := range []string{"天气", "天气很好", "适合写笔记"} {
+		results, err := ts.SearchMemos(ctx, &store.SearchMemo{Query: query})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(results), query)
+		require.Equal(t, memo.ID, results[0].Memo.ID)
+	}
+	results, err := ts.SearchMemos(ctx, &store.SearchMemo{Query: "天气"})
+	require.NoError(t, err)
+	require.Contains(t, results[0].Snippet, store.SearchHighlightStart+"天气"+store.SearchHighlightEnd)
+	ts.Close()
+}
+
+func TestMemoListByCursor(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memos := []*store.Memo{}
+	for i := 0; i < 5; i++ {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    fmt.Sprintf("content %d", i),
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+		memos = append(memos, memo)
+	}
+	pinned := true
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memos[1].ID, Pinned: &pinned})
+	require.NoError(t, err)
+	createdTs := memos[3].CreatedTs + 10
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memos[3].ID, CreatedTs: &createdTs})
+	require.NoError(t, err)
+
+	tests := []struct {
+		find *store.FindMemo
+		want []int
+	}{
+		{
+			find: &store.FindMemo{},
+			want: []int{3, 4, 2, 1, 0},
+		},
+		{
+			find: &store.FindMemo{OrderByPinned: true},
+			want: []int{1, 3, 4, 2, 0},
+		},
+		{
+			find: &store.FindMemo{OrderByPinned: true, OrderByTimeAsc: true},
+			want: []int{1, 0, 2, 4, 3},
+		},
+		{
+			find: &store.FindMemo{OrderByUpdatedTs: true},
+			want: []int{4, 3, 2, 1, 0},
+		},
+	}
+	for _, test := range tests {
+		limit := 2
+		test.find.Limit = &limit
+		got := []int32{}
+		for {
+			memoList, err := ts.ListMemos(ctx, test.find)
+			require.NoError(t, err)
+			for _, memo := range memoList {
+				got = append(got, memo.ID)
+			}
+			if len(memoList) < limit {
+				break
+			}
+			lastMemo := memoList[len(memoList)-1]
+			test.find.Cursor = &store.Cursor{ID: lastMemo.ID, Ts: lastMemo.CreatedTs, Pinned: lastMemo.Pinned}
+			if test.find.OrderByUpdatedTs {
+				test.find.Cursor.Ts = lastMemo.UpdatedTs
+			}
+		}
+		want := []int32{}
+		for _, index := range test.want {
+			want = append(want, memos[index].ID)
+		}
+		require.Equal(t, want, got)
+	}
+	ts.Close()
+}
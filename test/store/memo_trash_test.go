@@ -0,0 +1,93 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoTrashStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-memo",
+		CreatorID:  user.ID,
+		Content:    "test content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	comment, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-comment",
+		CreatorID:  user.ID,
+		Content:    "test comment",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{
+		MemoID:        comment.ID,
+		RelatedMemoID: memo.ID,
+		Type:          store.MemoRelationComment,
+	})
+	require.NoError(t, err)
+
+	// Trash the memo together with its comment.
+	err = ts.TrashMemo(ctx, memo)
+	require.NoError(t, err)
+	trashedStatus := store.Trashed
+	trashedMemos, err := ts.ListMemos(ctx, &store.FindMemo{
+		RowStatus: &trashedStatus,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(trashedMemos))
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.NotZero(t, memo.TrashedTs)
+	comment, err = ts.GetMemo(ctx, &store.FindMemo{ID: &comment.ID})
+	require.NoError(t, err)
+	require.Equal(t, memo.TrashedTs, comment.TrashedTs)
+	trashedMemos, err = ts.ListMemos(ctx, &store.FindMemo{
+		RowStatus:                &trashedStatus,
+		ExcludeTrashedWithParent: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(trashedMemos))
+	require.Equal(t, memo.ID, trashedMemos[0].ID)
+
+	// Restore brings back the comment.
+	err = ts.RestoreMemo(ctx, memo)
+	require.NoError(t, err)
+	normalStatus := store.Normal
+	normalMemos, err := ts.ListMemos(ctx, &store.FindMemo{
+		RowStatus: &normalStatus,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(normalMemos))
+	for _, normalMemo := range normalMemos {
+		require.Zero(t, normalMemo.TrashedTs)
+	}
+
+	// Purge deletes the memo and its comment.
+	err = ts.TrashMemo(ctx, memo)
+	require.NoError(t, err)
+	trashedTsBefore := memo.TrashedTs
+	trashedMemos, err = ts.ListMemos(ctx, &store.FindMemo{
+		RowStatus:       &trashedStatus,
+		TrashedTsBefore: &trashedTsBefore,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(trashedMemos))
+	err = ts.PurgeMemo(ctx, memo)
+	require.NoError(t, err)
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memos))
+	relations, err := ts.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(relations))
+	ts.Close()
+}
@@ -13,5 +13,5 @@ func TestGetCurrentSchemaVersion(t *testing.T) {
 
 	currentSchemaVersion, err := ts.GetCurrentSchemaVersion()
 	require.NoError(t, err)
-	require.Equal(t, "0.24.2", currentSchemaVersion)
+	require.Equal(t, "0.24.20", currentSchemaVersion)
 }
@@ -33,6 +33,12 @@ func TestReactionStore(t *testing.T) {
 	require.Len(t, reactions, 1)
 	require.Equal(t, reaction, reactions[0])
 
+	reactions, err = ts.ListReactions(ctx, &store.FindReaction{
+		ContentIDList: []string{contentID, "other_content_id"},
+	})
+	require.NoError(t, err)
+	require.Len(t, reactions, 1)
+
 	err = ts.DeleteReaction(ctx, &store.DeleteReaction{
 		ID: reaction.ID,
 	})
@@ -34,11 +34,13 @@ func resetTestingDB(ctx context.Context, profile *profile.Profile, dbDriver stor
 		_, err := dbDriver.GetDB().ExecContext(ctx, `
 		DROP TABLE IF EXISTS migration_history;
 		DROP TABLE IF EXISTS system_setting;
+		DROP TABLE IF EXISTS user_following;
 		DROP TABLE IF EXISTS user;
 		DROP TABLE IF EXISTS user_setting;
 		DROP TABLE IF EXISTS memo;
 		DROP TABLE IF EXISTS memo_organizer;
 		DROP TABLE IF EXISTS memo_relation;
+		DROP TABLE IF EXISTS memo_revision;
 		DROP TABLE IF EXISTS resource;
 		DROP TABLE IF EXISTS tag;
 		DROP TABLE IF EXISTS activity;
@@ -46,7 +48,19 @@ func resetTestingDB(ctx context.Context, profile *profile.Profile, dbDriver stor
 		DROP TABLE IF EXISTS idp;
 		DROP TABLE IF EXISTS inbox;
 		DROP TABLE IF EXISTS webhook;
-		DROP TABLE IF EXISTS reaction;`)
+		DROP TABLE IF EXISTS reaction;
+		DROP TABLE IF EXISTS memo_tag;
+		DROP TABLE IF EXISTS memo_share;
+		DROP TABLE IF EXISTS memo_acl;
+		DROP TABLE IF EXISTS tombstone;
+		DROP TABLE IF EXISTS idempotency_key;
+		DROP TABLE IF EXISTS channel;
+		DROP TABLE IF EXISTS channel_member;
+		DROP TABLE IF EXISTS message;
+		DROP TABLE IF EXISTS follow_request;
+		DROP TABLE IF EXISTS user_block;
+		DROP TABLE IF EXISTS user_group;
+		DROP TABLE IF EXISTS user_group_member;`)
 		if err != nil {
 			slog.Error("failed to reset testing db", slog.String("error", err.Error()))
 			panic(err)
@@ -56,10 +70,12 @@ func resetTestingDB(ctx context.Context, profile *profile.Profile, dbDriver stor
 		DROP TABLE IF EXISTS migration_history CASCADE;
 		DROP TABLE IF EXISTS system_setting CASCADE;
 		DROP TABLE IF EXISTS "user" CASCADE;
+		DROP TABLE IF EXISTS user_following CASCADE;
 		DROP TABLE IF EXISTS user_setting CASCADE;
 		DROP TABLE IF EXISTS memo CASCADE;
 		DROP TABLE IF EXISTS memo_organizer CASCADE;
 		DROP TABLE IF EXISTS memo_relation CASCADE;
+		DROP TABLE IF EXISTS memo_revision CASCADE;
 		DROP TABLE IF EXISTS resource CASCADE;
 		DROP TABLE IF EXISTS tag CASCADE;
 		DROP TABLE IF EXISTS activity CASCADE;
@@ -67,7 +83,19 @@ func resetTestingDB(ctx context.Context, profile *profile.Profile, dbDriver stor
 		DROP TABLE IF EXISTS idp CASCADE;
 		DROP TABLE IF EXISTS inbox CASCADE;
 		DROP TABLE IF EXISTS webhook CASCADE;
-		DROP TABLE IF EXISTS reaction CASCADE;`)
+		DROP TABLE IF EXISTS reaction CASCADE;
+		DROP TABLE IF EXISTS memo_tag CASCADE;
+		DROP TABLE IF EXISTS memo_share CASCADE;
+		DROP TABLE IF EXISTS memo_acl CASCADE;
+		DROP TABLE IF EXISTS tombstone CASCADE;
+		DROP TABLE IF EXISTS idempotency_key CASCADE;
+		DROP TABLE IF EXISTS channel CASCADE;
+		DROP TABLE IF EXISTS channel_member CASCADE;
+		DROP TABLE IF EXISTS message CASCADE;
+		DROP TABLE IF EXISTS follow_request CASCADE;
+		DROP TABLE IF EXISTS user_block CASCADE;
+		DROP TABLE IF EXISTS user_group CASCADE;
+		DROP TABLE IF EXISTS user_group_member CASCADE;`)
 		if err != nil {
 			slog.Error("failed to reset testing db", slog.String("error", err.Error()))
 			panic(err)
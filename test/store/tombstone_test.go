@@ -0,0 +1,80 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestTombstoneStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-memo",
+		CreatorID:  user.ID,
+		Content:    "test content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	resource, err := ts.CreateResource(ctx, &store.Resource{
+		UID:       "test-resource",
+		CreatorID: user.ID,
+		Filename:  "test.txt",
+		Type:      "text/plain",
+	})
+	require.NoError(t, err)
+
+	// Trashing the memo and deleting the resource record their tombstones.
+	err = ts.TrashMemo(ctx, memo)
+	require.NoError(t, err)
+	err = ts.DeleteResource(ctx, &store.DeleteResource{ID: resource.ID})
+	require.NoError(t, err)
+	tombstones, err := ts.ListTombstones(ctx, &store.FindTombstone{
+		CreatorID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(tombstones))
+	require.Equal(t, store.TombstoneTypeMemo, tombstones[0].Type)
+	require.Equal(t, memo.UID, tombstones[0].UID)
+	require.Equal(t, store.TombstoneReasonDeleted, tombstones[0].Reason)
+	require.Equal(t, store.TombstoneTypeResource, tombstones[1].Type)
+	require.Equal(t, resource.UID, tombstones[1].UID)
+
+	archivedTombstone, err := ts.CreateTombstone(ctx, &store.Tombstone{
+		CreatorID: user.ID,
+		Type:      store.TombstoneTypeMemo,
+		UID:       "archived-memo",
+		Reason:    store.TombstoneReasonArchived,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, archivedTombstone.CreatedTs)
+	memoType := store.TombstoneTypeMemo
+	memoTombstones, err := ts.ListTombstones(ctx, &store.FindTombstone{
+		CreatorID: &user.ID,
+		Type:      &memoType,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memoTombstones))
+
+	// The tombstones created after the time are listed.
+	createdTsAfter := archivedTombstone.CreatedTs
+	tombstones, err = ts.ListTombstones(ctx, &store.FindTombstone{
+		CreatorID:      &user.ID,
+		CreatedTsAfter: &createdTsAfter,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(tombstones))
+	createdTsAfter = archivedTombstone.CreatedTs - 1
+	tombstones, err = ts.ListTombstones(ctx, &store.FindTombstone{
+		CreatorID:      &user.ID,
+		CreatedTsAfter: &createdTsAfter,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(tombstones))
+	ts.Close()
+}
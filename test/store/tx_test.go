@@ -0,0 +1,71 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestStoreWithTx(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	// A failed transaction leaves no trace.
+	err = ts.WithTx(ctx, func(tx *store.Store) error {
+		memo, err := tx.CreateMemo(ctx, &store.Memo{
+			UID:        "rollback-memo",
+			CreatorID:  user.ID,
+			Content:    "test content",
+			Visibility: store.Public,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := tx.UpsertMemoRelation(ctx, &store.MemoRelation{
+			MemoID:        memo.ID,
+			RelatedMemoID: memo.ID,
+			Type:          store.MemoRelationReference,
+		}); err != nil {
+			return err
+		}
+		return errors.New("rollback")
+	})
+	require.ErrorContains(t, err, "rollback")
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memos))
+	relations, err := ts.ListMemoRelations(ctx, &store.FindMemoRelation{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(relations))
+
+	// Nested calls join the outer transaction.
+	err = ts.WithTx(ctx, func(tx *store.Store) error {
+		if _, err := tx.CreateMemo(ctx, &store.Memo{
+			UID:        "commit-memo",
+			CreatorID:  user.ID,
+			Content:    "test content",
+			Visibility: store.Public,
+		}); err != nil {
+			return err
+		}
+		return tx.WithTx(ctx, func(nested *store.Store) error {
+			memos, err := nested.ListMemos(ctx, &store.FindMemo{})
+			if err != nil {
+				return err
+			}
+			require.Equal(t, 1, len(memos))
+			return nil
+		})
+	})
+	require.NoError(t, err)
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memos))
+	ts.Close()
+}
@@ -0,0 +1,101 @@
+package teststore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestUserBlockStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	troll, err := ts.CreateUser(ctx, &store.User{
+		Username: "troll",
+		Role:     store.RoleUser,
+		Email:    "troll@test.com",
+		Nickname: "troll_nickname",
+	})
+	require.NoError(t, err)
+
+	// Blocking the same user twice keeps a single block.
+	for range 2 {
+		userBlock, err := ts.UpsertUserBlock(ctx, &store.UserBlock{
+			UserID:        user.ID,
+			BlockedUserID: troll.ID,
+			Type:          store.UserBlockTypeBlock,
+		})
+		require.NoError(t, err)
+		require.Equal(t, store.UserBlockTypeBlock, userBlock.Type)
+	}
+	_, err = ts.UpsertUserBlock(ctx, &store.UserBlock{
+		UserID:        user.ID,
+		BlockedUserID: troll.ID,
+		Type:          store.UserBlockTypeMute,
+	})
+	require.NoError(t, err)
+	userBlocks, err := ts.ListUserBlocks(ctx, &store.FindUserBlock{
+		UserID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(userBlocks))
+
+	err = ts.DeleteUserBlock(ctx, &store.DeleteUserBlock{
+		UserID:        user.ID,
+		BlockedUserID: troll.ID,
+		Type:          store.UserBlockTypeBlock,
+	})
+	require.NoError(t, err)
+	blockType := store.UserBlockTypeBlock
+	userBlock, err := ts.GetUserBlock(ctx, &store.FindUserBlock{
+		UserID: &user.ID,
+		Type:   &blockType,
+	})
+	require.NoError(t, err)
+	require.Nil(t, userBlock)
+	ts.Close()
+}
+
+func TestMemoListByMuter(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	troll, err := ts.CreateUser(ctx, &store.User{
+		Username: "troll",
+		Role:     store.RoleUser,
+		Email:    "troll@test.com",
+		Nickname: "troll_nickname",
+	})
+	require.NoError(t, err)
+	for _, creatorID := range []int32{user.ID, troll.ID} {
+		_, err = ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("memo-%d", creatorID),
+			CreatorID:  creatorID,
+			Content:    "test content",
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+	}
+	_, err = ts.UpsertUserBlock(ctx, &store.UserBlock{
+		UserID:        user.ID,
+		BlockedUserID: troll.ID,
+		Type:          store.UserBlockTypeMute,
+	})
+	require.NoError(t, err)
+
+	// The memos of the muted users are left out for the muter only.
+	for muterID, expected := range map[int32]int{user.ID: 1, troll.ID: 2} {
+		memos, err := ts.ListMemos(ctx, &store.FindMemo{
+			MuterID: &muterID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, expected, len(memos))
+	}
+	ts.Close()
+}
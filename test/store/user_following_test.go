@@ -0,0 +1,146 @@
+package teststore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestUserFollowingStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	friend, err := ts.CreateUser(ctx, &store.User{
+		Username: "friend",
+		Role:     store.RoleUser,
+		Email:    "friend@test.com",
+		Nickname: "friend_nickname",
+	})
+	require.NoError(t, err)
+	colleague, err := ts.CreateUser(ctx, &store.User{
+		Username: "colleague",
+		Role:     store.RoleUser,
+		Email:    "colleague@test.com",
+		Nickname: "colleague_nickname",
+	})
+	require.NoError(t, err)
+
+	// Following the same user twice keeps a single following.
+	for _, followingUserID := range []int32{friend.ID, friend.ID, colleague.ID} {
+		err = ts.FollowUser(ctx, &store.UserFollowing{
+			UserID:          user.ID,
+			FollowingUserID: followingUserID,
+		})
+		require.NoError(t, err)
+	}
+	userFollowings, err := ts.ListUserFollowings(ctx, &store.FindUserFollowing{
+		UserID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(userFollowings))
+	require.Equal(t, store.DefaultFollowTag, userFollowings[0].Tag)
+
+	tag := "work"
+	err = ts.UpdateUserFollowing(ctx, &store.UpdateUserFollowing{
+		UserID:          user.ID,
+		FollowingUserID: colleague.ID,
+		Tag:             &tag,
+	})
+	require.NoError(t, err)
+	userFollowing, err := ts.GetUserFollowing(ctx, &store.FindUserFollowing{
+		UserID: &user.ID,
+		Tag:    &tag,
+	})
+	require.NoError(t, err)
+	require.Equal(t, colleague.ID, userFollowing.FollowingUserID)
+
+	// The memos of a circle are the ones of the followed users with the tag.
+	for _, creatorID := range []int32{friend.ID, colleague.ID} {
+		_, err = ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("memo-%d", creatorID),
+			CreatorID:  creatorID,
+			Content:    "test content",
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+	}
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{
+		CreatorID: &user.ID,
+		IsFollow:  true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memos))
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{
+		CreatorID: &user.ID,
+		IsFollow:  true,
+		FollowTag: &tag,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memos))
+	require.Equal(t, colleague.ID, memos[0].CreatorID)
+
+	// Unfollowing removes the user from the circle.
+	err = ts.UnFollowUser(ctx, &store.UserFollowing{
+		UserID:          user.ID,
+		FollowingUserID: colleague.ID,
+	})
+	require.NoError(t, err)
+	userFollowing, err = ts.GetUserFollowing(ctx, &store.FindUserFollowing{
+		UserID: &user.ID,
+		Tag:    &tag,
+	})
+	require.NoError(t, err)
+	require.Nil(t, userFollowing)
+	ts.Close()
+}
+
+func TestMemoListByFollowers(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	follower, err := ts.CreateUser(ctx, &store.User{
+		Username: "follower",
+		Role:     store.RoleUser,
+		Email:    "follower@test.com",
+		Nickname: "follower_nickname",
+	})
+	require.NoError(t, err)
+	stranger, err := ts.CreateUser(ctx, &store.User{
+		Username: "stranger",
+		Role:     store.RoleUser,
+		Email:    "stranger@test.com",
+		Nickname: "stranger_nickname",
+	})
+	require.NoError(t, err)
+	err = ts.FollowUser(ctx, &store.UserFollowing{
+		UserID:          follower.ID,
+		FollowingUserID: user.ID,
+	})
+	require.NoError(t, err)
+	for _, visibility := range []store.Visibility{store.Public, store.Followers, store.Private} {
+		_, err = ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("memo-%s", visibility),
+			CreatorID:  user.ID,
+			Content:    "test content",
+			Visibility: visibility,
+		})
+		require.NoError(t, err)
+	}
+
+	// The FOLLOWERS memos are listed for the followers only.
+	for userID, expected := range map[int32]int{follower.ID: 2, stranger.ID: 1} {
+		memos, err := ts.ListMemos(ctx, &store.FindMemo{
+			VisibilityList: []store.Visibility{store.Public, store.Protected},
+			FollowerID:     &userID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, expected, len(memos))
+	}
+	ts.Close()
+}
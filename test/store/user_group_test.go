@@ -0,0 +1,61 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestUserGroupStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	member, err := ts.CreateUser(ctx, &store.User{
+		Username: "member",
+		Role:     store.RoleUser,
+		Email:    "member@test.com",
+		Nickname: "member_nickname",
+	})
+	require.NoError(t, err)
+	userGroup, err := ts.CreateUserGroup(ctx, &store.UserGroup{
+		CreatorID: user.ID,
+		Name:      "design",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "design", userGroup.Name)
+
+	name := "design team"
+	userGroup, err = ts.UpdateUserGroup(ctx, &store.UpdateUserGroup{ID: userGroup.ID, Name: &name})
+	require.NoError(t, err)
+	require.Equal(t, name, userGroup.Name)
+
+	err = ts.SetUserGroupMembers(ctx, userGroup.ID, []int32{user.ID, member.ID})
+	require.NoError(t, err)
+	members, err := ts.ListUserGroupMembers(ctx, &store.FindUserGroupMember{GroupID: &userGroup.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(members))
+	err = ts.SetUserGroupMembers(ctx, userGroup.ID, []int32{member.ID})
+	require.NoError(t, err)
+	userGroups, err := ts.ListUserGroups(ctx, &store.FindUserGroup{MemberID: &member.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(userGroups))
+	require.Equal(t, userGroup.ID, userGroups[0].ID)
+	userGroups, err = ts.ListUserGroups(ctx, &store.FindUserGroup{MemberID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(userGroups))
+
+	// Deleting the group deletes its members.
+	err = ts.DeleteUserGroup(ctx, &store.DeleteUserGroup{ID: userGroup.ID})
+	require.NoError(t, err)
+	userGroup, err = ts.GetUserGroup(ctx, &store.FindUserGroup{ID: &userGroup.ID})
+	require.NoError(t, err)
+	require.Nil(t, userGroup)
+	members, err = ts.ListUserGroupMembers(ctx, &store.FindUserGroupMember{UserID: &member.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(members))
+	ts.Close()
+}
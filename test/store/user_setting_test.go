@@ -26,3 +26,31 @@ func TestUserSettingStore(t *testing.T) {
 	require.Equal(t, 1, len(list))
 	ts.Close()
 }
+
+func TestUserSettingStoreTags(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	tagsUserSetting := &storepb.TagsUserSetting{
+		Tags: []*storepb.TagsUserSetting_Tag{
+			{Tag: "work/project", Color: "#ff0000", Pinned: true, Emoji: "🚀"},
+		},
+	}
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_TAGS,
+		Value:  &storepb.UserSetting_Tags{Tags: tagsUserSetting},
+	})
+	require.NoError(t, err)
+	userSetting, err := ts.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSettingKey_TAGS,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(userSetting.GetTags().GetTags()))
+	require.Equal(t, "work/project", userSetting.GetTags().GetTags()[0].Tag)
+	require.Equal(t, "#ff0000", userSetting.GetTags().GetTags()[0].Color)
+	require.True(t, userSetting.GetTags().GetTags()[0].Pinned)
+	ts.Close()
+}
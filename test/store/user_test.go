@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/bcrypt"
 
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
@@ -38,6 +39,55 @@ func TestUserStore(t *testing.T) {
 	ts.Close()
 }
 
+func TestUserListByFilter(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	location := "Shanghai"
+	for _, username := range []string{"steven", "stella"} {
+		created, err := ts.CreateUser(ctx, &store.User{
+			Username: username,
+			Role:     store.RoleUser,
+			Email:    username + "@test.com",
+			Nickname: username + "_nickname",
+		})
+		require.NoError(t, err)
+		_, err = ts.UpdateUser(ctx, &store.UpdateUser{
+			ID:       created.ID,
+			Location: &location,
+		})
+		require.NoError(t, err)
+		user = created
+	}
+
+	filter := `nickname.contains("ste") && location == "Shanghai"`
+	users, err := ts.ListUsers(ctx, &store.FindUser{Filter: &filter})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(users))
+
+	// The private location of the user never matches.
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_PROFILE_PRIVACY,
+		Value: &storepb.UserSetting_ProfilePrivacy{
+			ProfilePrivacy: &storepb.ProfilePrivacyUserSetting{PrivateFields: []string{"location"}},
+		},
+	})
+	require.NoError(t, err)
+	users, err = ts.ListUsers(ctx, &store.FindUser{Filter: &filter})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(users))
+	require.NotEqual(t, user.ID, users[0].ID)
+
+	limit, offset := 1, 1
+	filter = `nickname.contains("ste")`
+	users, err = ts.ListUsers(ctx, &store.FindUser{Filter: &filter, Limit: &limit, Offset: &offset})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(users))
+	ts.Close()
+}
+
 func createTestingHostUser(ctx context.Context, ts *store.Store) (*store.User, error) {
 	userCreate := &store.User{
 		Username:    "test",
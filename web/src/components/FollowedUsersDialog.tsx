@@ -91,15 +91,14 @@ const FollowedUsersDialog: React.FC<FollowedUsersDialogProps> = ({ destroy, owne
   //   const [isFollowing, setIsFollowing] = useState<boolean | null>(null);
   const handleFollow = async (followingUser: UserPb) => {
     try {
-      const followingData = await userStore1.followUser(
-        UserFollowingPb.fromPartial({
-          userName: currentUser.username,
-          followingUserName: followingUser.username,
-        }),
-      );
+      const beforeStatus = userFollowingStatus[followingUser.name];
+      const userFollowing = UserFollowingPb.fromPartial({
+        userName: currentUser.username,
+        followingUserName: followingUser.username,
+      });
+      const followingData = beforeStatus ? await userStore1.unfollowUser(userFollowing) : await userStore1.followUser(userFollowing);
       if (followingData) {
         // setIsFollowing(!isFollowing);
-        const beforeStatus = userFollowingStatus[followingUser.name];
         setUserFollowingStatus((prevStatus) => ({
           ...prevStatus,
           [followingUser.name]: !prevStatus[followingUser.name],
@@ -735,7 +735,7 @@ const MemoEditor = observer((props: Props): JSX.Element => {
                 }}
               >
                 {/* [Visibility.PRIVATE, Visibility.PROTECTED, Visibility.PUBLIC] */}
-                {[Visibility.PUBLIC, Visibility.PRIVATE].map((item) => (
+                {[Visibility.PUBLIC, Visibility.FOLLOWERS, Visibility.PRIVATE].map((item) => (
                   <Option key={item} value={item} className="whitespace-nowrap !text-sm">
                     {t(`memo.visibility.${convertVisibilityToString(item).toLowerCase()}` as any)}
                   </Option>
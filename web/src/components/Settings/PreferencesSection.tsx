@@ -1,4 +1,4 @@
-import { Divider, Option, Select } from "@mui/joy";
+import { Divider, Option, Select, Switch } from "@mui/joy";
 import { observer } from "mobx-react-lite";
 import useCurrentUser from "@/hooks/useCurrentUser";
 import { userStore } from "@/store/v2";
@@ -42,6 +42,15 @@ const PreferencesSection = observer(() => {
     );
   };
 
+  const handleApproveFollowersChanged = async (approveFollowers: boolean) => {
+    await userStore.updateUserSetting(
+      {
+        approveFollowers,
+      },
+      ["approve_followers"],
+    );
+  };
+
   const user = useCurrentUser();
   const isHost = user.role === User_Role.HOST;
 
@@ -85,6 +94,10 @@ const PreferencesSection = observer(() => {
             ))} */}
         </Select>
       </div>
+      <div className="flex flex-row items-center justify-between w-full">
+        <span className="truncate">{t("setting.preference-section.approve-followers")}</span>
+        <Switch checked={setting.approveFollowers} onChange={(event) => handleApproveFollowersChanged(event.target.checked)} />
+      </div>
       {isHost && <WebhookSection />}
     </div>
   );
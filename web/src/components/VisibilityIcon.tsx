@@ -1,4 +1,4 @@
-import { Globe2Icon, LockIcon, UsersIcon } from "lucide-react";
+import { Globe2Icon, LockIcon, UserCheckIcon, UsersIcon } from "lucide-react";
 import { Visibility } from "@/types/proto/api/v1/memo_service";
 import { cn } from "@/utils";
 
@@ -14,6 +14,8 @@ const VisibilityIcon = (props: Props) => {
     VIcon = LockIcon;
   } else if (visibility === Visibility.PROTECTED) {
     VIcon = UsersIcon;
+  } else if (visibility === Visibility.FOLLOWERS) {
+    VIcon = UserCheckIcon;
   } else if (visibility === Visibility.PUBLIC) {
     VIcon = Globe2Icon;
   }
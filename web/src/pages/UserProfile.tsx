@@ -271,12 +271,11 @@ const UserProfile = () => {
     // 实现关注逻辑
     try {
       if (currentUser?.username && user?.username) {
-        const followingData = await userStore1.followUser(
-          UserFollowingPb.fromPartial({
-            userName: currentUser?.username,
-            followingUserName: user?.username,
-          }),
-        );
+        const userFollowing = UserFollowingPb.fromPartial({
+          userName: currentUser?.username,
+          followingUserName: user?.username,
+        });
+        const followingData = isFollowing ? await userStore1.unfollowUser(userFollowing) : await userStore1.followUser(userFollowing);
         // setUserFollowing(followingData);
         if (followingData) {
           setIsFollowing(!isFollowing);
@@ -141,6 +141,12 @@ export const useUserStore = create(
       });
       return response;
     },
+    unfollowUser: async (userFollowing: Partial<UserFollowing>) => {
+      await userServiceClient.unfollowUser({
+        follow: userFollowing,
+      });
+      return true;
+    },
     getFollowingList: async (userID: string) => {
       const followingList = await userServiceClient.getFollowingList({ name: userID });
       return followingList;
@@ -6,6 +6,8 @@ export const convertVisibilityFromString = (visibility: string) => {
       return Visibility.PUBLIC;
     case "PROTECTED":
       return Visibility.PROTECTED;
+    case "FOLLOWERS":
+      return Visibility.FOLLOWERS;
     case "PRIVATE":
       return Visibility.PRIVATE;
     default:
@@ -19,6 +21,8 @@ export const convertVisibilityToString = (visibility: Visibility) => {
       return "PUBLIC";
     case Visibility.PROTECTED:
       return "PROTECTED";
+    case Visibility.FOLLOWERS:
+      return "FOLLOWERS";
     case Visibility.PRIVATE:
       return "PRIVATE";
     default: